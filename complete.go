@@ -0,0 +1,174 @@
+package gomoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Suggestion is an emoji suggested for a partially typed shortcode.
+type Suggestion struct {
+	Emoji
+	// Shortcode is the preferred shortcode of the emoji in the set, without delimiters, e.g. "partying_face".
+	Shortcode string
+	// Match is the shortcode, slug or keyword that starts with the typed prefix, e.g. "party".
+	Match string
+}
+
+// CompleteOption configures Complete.
+type CompleteOption func(*completeOptions)
+
+type completeOptions struct {
+	usage map[string]int
+}
+
+// completionIndex is the list of shortcodes, slugs and keywords of a ShortcodeSet sorted for prefix search.
+type completionIndex struct {
+	once  sync.Once
+	terms []completionTerm
+}
+
+type completionTerm struct {
+	term      string
+	character string
+	kind      int
+	// order is the position of the emoji in AllEmojis.
+	order int
+}
+
+// Completion term kinds, from the best to the worst. An exact shortcode match ranks before any other.
+const (
+	termExactShortcode = iota
+	termShortcode
+	termSlug
+	termKeyword
+)
+
+// WithUsage boosts the emojis the user has used before. The counts map any spelling of an emoji to
+// the number of times it was used; emojis used more often are suggested first.
+func WithUsage(counts map[string]int) CompleteOption {
+	return func(o *completeOptions) {
+		for character, count := range counts {
+			o.usage[withoutVariationSelectors(character)] += count
+		}
+	}
+}
+
+// Complete suggests up to n emojis whose GitHub shortcode, slug or keyword starts with the prefix, e.g.
+// :partying_face:, :parrot: and :parachute: for ":par". See ShortcodeSet.Complete.
+func Complete(prefix string, n int, opts ...CompleteOption) []Suggestion {
+	return GitHubShortcodes.Complete(prefix, n, opts...)
+}
+
+// Complete suggests up to n emojis whose shortcode of the set, slug or keyword starts with the prefix.
+// The prefix is case-insensitive and may start with the open delimiter. Emojis used more often according to
+// WithUsage come first, followed by the exact shortcode match and the emojis matched by a shortcode, a slug
+// and a keyword. Ties are ordered like AllEmojis. If n is not positive, all suggestions are returned.
+// If nothing matches, it returns a nil-slice.
+func (set *ShortcodeSet) Complete(prefix string, n int, opts ...CompleteOption) []Suggestion {
+	prefix = strings.ToLower(strings.TrimPrefix(prefix, set.open))
+	if prefix == "" {
+		return nil
+	}
+
+	o := completeOptions{usage: make(map[string]int)}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	less := func(a, b completionTerm) bool {
+		if ua, ub := o.usage[a.character], o.usage[b.character]; ua != ub {
+			return ua > ub
+		}
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		return a.order < b.order
+	}
+
+	// The best n terms are kept sorted while scanning, at most one per emoji.
+	var matches []completionTerm
+	kept := make(map[string]bool)
+	terms := set.completions()
+	for i := sort.Search(len(terms), func(i int) bool {
+		return terms[i].term >= prefix
+	}); i < len(terms) && strings.HasPrefix(terms[i].term, prefix); i++ {
+		t := terms[i]
+		if t.kind == termShortcode && t.term == prefix {
+			t.kind = termExactShortcode
+		}
+		if n > 0 && len(matches) == n && !less(t, matches[n-1]) {
+			continue
+		}
+
+		if kept[t.character] {
+			j := 0
+			for matches[j].character != t.character {
+				j++
+			}
+			if !less(t, matches[j]) {
+				continue
+			}
+			matches = append(matches[:j], matches[j+1:]...)
+		}
+
+		j := sort.Search(len(matches), func(j int) bool {
+			return less(t, matches[j])
+		})
+		matches = append(matches, completionTerm{})
+		copy(matches[j+1:], matches[j:])
+		matches[j] = t
+		kept[t.character] = true
+
+		if n > 0 && len(matches) > n {
+			delete(kept, matches[n].character)
+			matches = matches[:n]
+		}
+	}
+
+	byEmoji := set.index().byEmoji
+	var suggestions []Suggestion
+	for _, t := range matches {
+		em, _ := defaultMatcher.lookup(t.character)
+		suggestions = append(suggestions, Suggestion{
+			Emoji:     em,
+			Shortcode: byEmoji[t.character][0],
+			Match:     t.term,
+		})
+	}
+
+	return suggestions
+}
+
+func (set *ShortcodeSet) completions() []completionTerm {
+	idx := set.index()
+	idx.completions.once.Do(func() {
+		idx.completions.build(idx)
+	})
+
+	return idx.completions.terms
+}
+
+// build indexes the shortcodes of the set together with the slugs and keywords of the emojis that have a shortcode.
+// Like Search, it leaves out skin tone variants. Terms are keyed by the spelling without variation selectors.
+func (c *completionIndex) build(idx *shortcodeIndex) {
+	for order, entry := range defaultMatcher.searches.get(defaultMatcher).entries {
+		key := withoutVariationSelectors(entry.emoji.Character)
+		aliases := idx.byEmoji[key]
+		if len(aliases) == 0 {
+			continue
+		}
+
+		for _, alias := range aliases {
+			c.terms = append(c.terms, completionTerm{term: strings.ToLower(alias), character: key, kind: termShortcode, order: order})
+		}
+		c.terms = append(c.terms, completionTerm{term: strings.ToLower(entry.emoji.Slug), character: key, kind: termSlug, order: order})
+		for _, k := range entry.keywords {
+			c.terms = append(c.terms, completionTerm{term: k, character: key, kind: termKeyword, order: order})
+		}
+	}
+
+	sort.Slice(c.terms, func(i, j int) bool {
+		return c.terms[i].term < c.terms[j].term
+	})
+}
@@ -0,0 +1,105 @@
+package gomoji_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		n      int
+		opts   []gomoji.CompleteOption
+		want   []string
+	}{
+		{
+			name:   "shortcode prefix",
+			prefix: ":parr",
+			want:   []string{"parrot"},
+		},
+		{
+			name:   "ties are ordered like AllEmojis",
+			prefix: ":par",
+			n:      4,
+			want:   []string{"partying_face", "parrot", "parachute", "partly_sunny"},
+		},
+		{
+			name:   "shortcodes rank before slugs",
+			prefix: ":party",
+			n:      2,
+			want:   []string{"partying_face", "tada"},
+		},
+		{
+			name:   "exact shortcode ranks first",
+			prefix: "tada",
+			want:   []string{"tada"},
+		},
+		{
+			name:   "keyword",
+			prefix: "happ",
+			want:   []string{"grinning", "joy", "slightly_smiling_face", "blush"},
+		},
+		{
+			name:   "usage boost",
+			prefix: ":PAR",
+			n:      2,
+			opts:   []gomoji.CompleteOption{gomoji.WithUsage(map[string]int{"🥳": 5, "🎉": 2})},
+			want:   []string{"partying_face", "tada"},
+		},
+		{
+			name:   "skin tone variants are left out",
+			prefix: "thumbs",
+			want:   []string{"+1", "-1"},
+		},
+		{
+			name:   "empty prefix",
+			prefix: ":",
+			want:   nil,
+		},
+		{
+			name:   "nothing found",
+			prefix: "qwerty",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range gomoji.Complete(tt.prefix, tt.n, tt.opts...) {
+				got = append(got, s.Shortcode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Complete() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteLimit(t *testing.T) {
+	// The best n suggestions are the first n of all suggestions.
+	all := gomoji.Complete(":s", 0)
+	for _, n := range []int{1, 10, 100} {
+		if got := gomoji.Complete(":s", n); !reflect.DeepEqual(got, all[:n]) {
+			t.Errorf("Complete(%d) is not the prefix of all suggestions", n)
+		}
+	}
+}
+
+func TestCompleteSuggestion(t *testing.T) {
+	got := gomoji.SlackStyleShortcodes.Complete("+", 1)
+	if len(got) != 1 {
+		t.Fatalf("Complete() returned %d suggestions, want 1", len(got))
+	}
+	if got[0].Character != "👍" || got[0].Match != "+1" {
+		t.Errorf("Complete() = %q matched by %q, want 👍 matched by +1", got[0].Character, got[0].Match)
+	}
+}
+
+func BenchmarkComplete(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gomoji.Complete(":s", 10)
+	}
+}
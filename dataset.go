@@ -0,0 +1,170 @@
+package gomoji
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Dataset is an emoji list loaded from the Unicode data files instead of the bundled one, e.g. to pin
+// a different Unicode version. Use it with a Matcher through WithDataset.
+type Dataset struct {
+	// Version is the emoji version stated in the file header, or zero if the file does not state it.
+	Version Version
+
+	emojis   []Emoji
+	metadata map[string]Metadata
+}
+
+// Metadata is the data of an emoji in third-party datasets that the Emoji model does not cover.
+type Metadata struct {
+	// Shortcodes are the shortcodes of the emoji without delimiters, preferred one first.
+	Shortcodes []string
+	// Tags are the search keywords of the emoji.
+	Tags []string
+	// SheetX and SheetY are the column and row of the emoji image in the emoji-datasource sprite sheets.
+	SheetX, SheetY int
+	// UnicodeVersion is the Unicode version of the characters of the emoji as stated by gemoji, e.g. 6.1 for 😀.
+	// Unlike the emoji version, it is zero for emojis that are sequences of older characters.
+	UnicodeVersion Version
+}
+
+// NewDataset returns a Dataset of the emojis in the given order, e.g. NewDataset(AllEmojis()) to export
+// the bundled list.
+func NewDataset(emojis []Emoji) *Dataset {
+	return &Dataset{emojis: append([]Emoji(nil), emojis...)}
+}
+
+// LoadEmojiTest parses the Unicode emoji-test.txt file into a Dataset, keeping the order of the file.
+// Every line becomes an Emoji with its group, subgroup, status, version and name; the slug is derived
+// from the name like in the bundled list. The file lists every RGI sequence of emoji-sequences.txt and
+// emoji-zwj-sequences.txt in all its spellings, so these files are not needed. If a line is malformed,
+// it returns an error wrapping the gomoji.ErrInvalidEmojiTest error.
+func LoadEmojiTest(r io.Reader) (*Dataset, error) {
+	d := &Dataset{}
+	seen := make(map[string]bool)
+
+	var group, subGroup string
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+
+		if comment, ok := cutComment(line); ok {
+			switch key, value, _ := strings.Cut(comment, ":"); key {
+			case "group":
+				group = strings.TrimSpace(value)
+			case "subgroup":
+				subGroup = strings.TrimSpace(value)
+			case "Version":
+				d.Version, _ = ParseVersion(strings.TrimSpace(value))
+			}
+			continue
+		}
+		if line == "" {
+			continue
+		}
+
+		em, err := parseEmojiTestLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidEmojiTest, n, err)
+		}
+		if seen[em.Character] {
+			continue
+		}
+
+		seen[em.Character] = true
+		em.Group, em.SubGroup = group, subGroup
+		d.emojis = append(d.emojis, em)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Emojis returns the emojis of the dataset in the order of the file.
+func (d *Dataset) Emojis() []Emoji {
+	return append([]Emoji(nil), d.emojis...)
+}
+
+// Metadata returns the metadata of the emoji, in the exact spelling, imported from a third-party dataset.
+// It reports false if the dataset has no metadata for the emoji.
+func (d *Dataset) Metadata(e Emoji) (Metadata, bool) {
+	md, ok := d.metadata[e.Character]
+	return md, ok
+}
+
+// add appends the emoji with its metadata unless the dataset already has the spelling.
+func (d *Dataset) add(em Emoji, md Metadata) {
+	if d.metadata == nil {
+		d.metadata = make(map[string]Metadata)
+	}
+	if _, ok := d.metadata[em.Character]; ok {
+		return
+	}
+
+	d.emojis = append(d.emojis, em)
+	d.metadata[em.Character] = md
+}
+
+// parseEmojiTestLine parses a data line of emoji-test.txt such as
+// "1F600 ; fully-qualified # 😀 E1.0 grinning face". The group and subgroup are left empty.
+func parseEmojiTestLine(line string) (Emoji, error) {
+	data, comment, ok := strings.Cut(line, "#")
+	if !ok {
+		return Emoji{}, errors.New("missing comment")
+	}
+	codePoints, status, ok := strings.Cut(data, ";")
+	if !ok {
+		return Emoji{}, errors.New("missing status")
+	}
+
+	runes, err := parseCodePoints(codePoints)
+	if err != nil {
+		return Emoji{}, err
+	}
+	st, err := ParseStatus(strings.TrimSpace(status))
+	if err != nil || st == StatusUnknown {
+		return Emoji{}, ErrInvalidStatus
+	}
+
+	// The comment is the emoji itself followed by its version and name, e.g. "😀 E1.0 grinning face".
+	fields := strings.Fields(comment)
+	if len(fields) < 3 {
+		return Emoji{}, errors.New("missing name")
+	}
+	version, err := ParseVersion(fields[1])
+	if err != nil {
+		return Emoji{}, err
+	}
+	name := strings.Join(fields[2:], " ")
+
+	return Emoji{
+		Slug:        nameToSlug(name),
+		Character:   string(runes),
+		UnicodeName: fields[1] + " " + name,
+		CodePoint:   strings.Join(strings.Fields(codePoints), " "),
+		Name:        name,
+		Version:     version,
+		CodePoints:  runes,
+		Status:      st,
+	}, nil
+}
+
+// cutComment returns the text of a comment line without the number sign, e.g. "group: Smileys & Emotion".
+func cutComment(line string) (string, bool) {
+	if !strings.HasPrefix(line, "#") {
+		return "", false
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(line, "#")), true
+}
+
+// nameToSlug derives the slug from the CLDR name the way the bundled list does, e.g. "flag-st.-lucia"
+// from "flag: St. Lucia".
+func nameToSlug(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.ToLower(name), ":", ""), " ", "-")
+}
@@ -0,0 +1,139 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const emojiTestSample = `# emoji-test.txt
+# Version: 15.1
+#
+# Format: code points; status # emoji name
+
+# group: Smileys & Emotion
+
+# subgroup: face-smiling
+1F603                                                  ; fully-qualified     # 😃 E0.6 grinning face with big eyes
+1F600                                                  ; fully-qualified     # 😀 E1.0 grinning face
+
+# subgroup: emotion
+2764 FE0F                                              ; fully-qualified     # ❤️ E0.6 red heart
+2764                                                   ; unqualified         # ❤ E0.6 red heart
+
+# group: Flags
+
+# subgroup: country-flag
+1F1E8 1F1ED                                            ; fully-qualified     # 🇨🇭 E2.0 flag: Switzerland
+
+# Status Counts
+# fully-qualified : 4
+# unqualified : 1
+
+#EOF
+`
+
+func TestLoadEmojiTest(t *testing.T) {
+	d, err := gomoji.LoadEmojiTest(strings.NewReader(emojiTestSample))
+	if err != nil {
+		t.Fatalf("LoadEmojiTest() error = %v", err)
+	}
+	if want := (gomoji.Version{Major: 15, Minor: 1}); d.Version != want {
+		t.Errorf("Version = %v, want %v", d.Version, want)
+	}
+
+	emojis := d.Emojis()
+	var characters []string
+	for _, em := range emojis {
+		characters = append(characters, em.Character)
+	}
+	if want := []string{"😃", "😀", "❤️", "❤", "🇨🇭"}; !reflect.DeepEqual(characters, want) {
+		t.Fatalf("Emojis() = %q, want %q", characters, want)
+	}
+
+	want := gomoji.Emoji{
+		Slug:        "red-heart",
+		Character:   "❤️",
+		UnicodeName: "E0.6 red heart",
+		CodePoint:   "2764 FE0F",
+		Group:       "Smileys & Emotion",
+		SubGroup:    "emotion",
+		Name:        "red heart",
+		Version:     gomoji.Version{Major: 0, Minor: 6},
+		CodePoints:  []rune{0x2764, 0xFE0F},
+		Status:      gomoji.StatusFullyQualified,
+	}
+	if !reflect.DeepEqual(emojis[2], want) {
+		t.Errorf("Emojis()[2] = %+v, want %+v", emojis[2], want)
+	}
+	if got, want := emojis[4].Slug, "flag-switzerland"; got != want {
+		t.Errorf("Slug = %q, want %q", got, want)
+	}
+}
+
+func TestLoadEmojiTestInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{
+			name: "missing comment",
+			line: "1F600 ; fully-qualified",
+		},
+		{
+			name: "missing status",
+			line: "1F600 # 😀 E1.0 grinning face",
+		},
+		{
+			name: "invalid code point",
+			line: "1F60G ; fully-qualified # 😀 E1.0 grinning face",
+		},
+		{
+			name: "invalid status",
+			line: "1F600 ; qualified # 😀 E1.0 grinning face",
+		},
+		{
+			name: "invalid version",
+			line: "1F600 ; fully-qualified # 😀 grinning face",
+		},
+		{
+			name: "missing name",
+			line: "1F600 ; fully-qualified # 😀 E1.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gomoji.LoadEmojiTest(strings.NewReader("# group: Smileys & Emotion\n" + tt.line + "\n"))
+			if !errors.Is(err, gomoji.ErrInvalidEmojiTest) {
+				t.Fatalf("LoadEmojiTest() error = %v, want %v", err, gomoji.ErrInvalidEmojiTest)
+			}
+			if !strings.Contains(err.Error(), "line 2") {
+				t.Errorf("LoadEmojiTest() error = %v, want the line number", err)
+			}
+		})
+	}
+}
+
+func TestWithDataset(t *testing.T) {
+	d, err := gomoji.LoadEmojiTest(strings.NewReader(emojiTestSample))
+	if err != nil {
+		t.Fatalf("LoadEmojiTest() error = %v", err)
+	}
+
+	m := gomoji.New(gomoji.WithDataset(d), gomoji.WithoutGroups("Flags"), gomoji.WithEmojis(gomoji.Emoji{Character: "🐹"}))
+
+	var all []string
+	for _, em := range m.AllEmojis() {
+		all = append(all, em.Character)
+	}
+	if want := []string{"😃", "😀", "❤️", "❤", "🐹"}; !reflect.DeepEqual(all, want) {
+		t.Errorf("AllEmojis() = %q, want %q", all, want)
+	}
+
+	if got, want := m.ReplaceWithSlug("🦋 😀 ❤ 🇨🇭 🐹"), "🦋 grinning-face red-heart 🇨🇭 "; got != want {
+		t.Errorf("ReplaceWithSlug() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,185 @@
+package gomoji
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// datasourceEntry is an emoji in the emoji.json file of iamcal/emoji-data (emoji-datasource).
+type datasourceEntry struct {
+	Name           string                         `json:"name"`
+	Unified        string                         `json:"unified"`
+	NonQualified   *string                        `json:"non_qualified"`
+	Image          string                         `json:"image"`
+	SheetX         int                            `json:"sheet_x"`
+	SheetY         int                            `json:"sheet_y"`
+	ShortName      string                         `json:"short_name"`
+	ShortNames     []string                       `json:"short_names"`
+	Category       string                         `json:"category"`
+	Subcategory    string                         `json:"subcategory"`
+	SortOrder      int                            `json:"sort_order"`
+	AddedIn        string                         `json:"added_in"`
+	SkinVariations map[string]datasourceVariation `json:"skin_variations,omitempty"`
+}
+
+// datasourceVariation is a toned variant of an emoji in emoji-datasource, keyed by its skin tone code points.
+type datasourceVariation struct {
+	Unified      string  `json:"unified"`
+	NonQualified *string `json:"non_qualified"`
+	Image        string  `json:"image"`
+	SheetX       int     `json:"sheet_x"`
+	SheetY       int     `json:"sheet_y"`
+	AddedIn      string  `json:"added_in"`
+}
+
+// LoadEmojiDatasource builds a Dataset from the emoji.json file of emoji-datasource, ordered by sort_order.
+// The non_qualified code points become unqualified spellings and the skin_variations toned variants.
+// The short names and the sprite sheet coordinates become the Metadata, and added_in becomes the Version.
+// emoji-datasource has Unicode character names rather than CLDR names, so the names are lower-cased character
+// names, or the short name for sequences without one. If the JSON is malformed, it returns an error wrapping
+// the gomoji.ErrInvalidDataset error.
+func LoadEmojiDatasource(r io.Reader) (*Dataset, error) {
+	var entries []datasourceEntry
+	if err := decodeJSON(r, &entries); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].SortOrder < entries[j].SortOrder
+	})
+
+	d := &Dataset{}
+	for _, entry := range entries {
+		name := strings.ToLower(entry.Name)
+		if name == "" {
+			name = strings.ReplaceAll(entry.ShortName, "_", " ")
+		}
+		md := Metadata{Shortcodes: entry.ShortNames, SheetX: entry.SheetX, SheetY: entry.SheetY}
+
+		if err := entry.addSpellings(d, entry.Unified, entry.NonQualified, entry.AddedIn, name, md); err != nil {
+			return nil, err
+		}
+
+		tones := make([]string, 0, len(entry.SkinVariations))
+		for tone := range entry.SkinVariations {
+			tones = append(tones, tone)
+		}
+		sort.Strings(tones)
+
+		for _, tone := range tones {
+			v := entry.SkinVariations[tone]
+			toned := datasourceVariationName(name, v.Unified)
+			tonedMetadata := Metadata{SheetX: v.SheetX, SheetY: v.SheetY}
+			if err := entry.addSpellings(d, v.Unified, v.NonQualified, v.AddedIn, toned, tonedMetadata); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// WriteEmojiDatasource writes the fully-qualified emojis of the dataset in the emoji.json format of
+// emoji-datasource, with the toned variants as skin_variations. Emojis without imported short names get
+// their Slack shortcodes, and the sprite sheet coordinates are zero unless imported.
+func WriteEmojiDatasource(w io.Writer, d *Dataset) error {
+	spellings := d.spellings()
+
+	entries := []datasourceEntry{}
+	for i, f := range d.toneFamilies() {
+		md, _ := d.Metadata(f.base)
+		shortNames := append([]string{}, d.shortcodes(f.base, SlackStyleShortcodes)...)
+		entry := datasourceEntry{
+			Name:         strings.ToUpper(f.base.Name),
+			Unified:      hexcode(f.base.CodePoints, true),
+			NonQualified: datasourceNonQualified(f.base, spellings),
+			Image:        strings.ToLower(hexcode(f.base.CodePoints, true)) + ".png",
+			SheetX:       md.SheetX,
+			SheetY:       md.SheetY,
+			ShortNames:   shortNames,
+			Category:     f.base.Group,
+			Subcategory:  f.base.SubGroup,
+			SortOrder:    i + 1,
+			AddedIn:      f.base.Version.String(),
+		}
+		if len(shortNames) > 0 {
+			entry.ShortName = shortNames[0]
+		}
+
+		for _, toned := range f.toned {
+			if entry.SkinVariations == nil {
+				entry.SkinVariations = make(map[string]datasourceVariation)
+			}
+
+			var modifiers []rune
+			for _, tone := range SkinTones(toned) {
+				modifiers = append(modifiers, tone.Modifier())
+			}
+
+			md, _ := d.Metadata(toned)
+			entry.SkinVariations[hexcode(modifiers, false)] = datasourceVariation{
+				Unified:      hexcode(toned.CodePoints, true),
+				NonQualified: datasourceNonQualified(toned, spellings),
+				Image:        strings.ToLower(hexcode(toned.CodePoints, true)) + ".png",
+				SheetX:       md.SheetX,
+				SheetY:       md.SheetY,
+				AddedIn:      toned.Version.String(),
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return encodeJSON(w, entries)
+}
+
+// addSpellings adds the fully-qualified spelling and, if any, the unqualified spelling of the emoji to the dataset.
+func (e datasourceEntry) addSpellings(d *Dataset, unified string, nonQualified *string, addedIn, name string, md Metadata) error {
+	version, err := ParseVersion(addedIn)
+	if err != nil && addedIn != "" {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidDataset, unified, err)
+	}
+	runes, err := parseCodePoints(unified)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidDataset, unified, err)
+	}
+
+	em := newImportedEmoji(runes, name, version, StatusFullyQualified)
+	em.Group, em.SubGroup = e.Category, e.Subcategory
+	d.add(em, md)
+
+	if nonQualified != nil && *nonQualified != "" {
+		runes, err := parseCodePoints(*nonQualified)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidDataset, *nonQualified, err)
+		}
+
+		unqualified := newImportedEmoji(runes, name, version, StatusUnqualified)
+		unqualified.Group, unqualified.SubGroup = e.Category, e.Subcategory
+		d.add(unqualified, md)
+	}
+
+	return nil
+}
+
+// datasourceVariationName returns the name of a toned variant from the skin tones in its code points.
+func datasourceVariationName(name, unified string) string {
+	runes, err := parseCodePoints(unified)
+	if err != nil {
+		return name
+	}
+
+	return tonedName(name, SkinTones(Emoji{Character: string(runes)}))
+}
+
+// datasourceNonQualified returns the non_qualified code points of the emoji if the dataset has the spelling.
+func datasourceNonQualified(em Emoji, spellings map[string]bool) *string {
+	unqualified, ok := unqualifiedSpelling(em, spellings)
+	if !ok {
+		return nil
+	}
+
+	code := hexcode([]rune(unqualified), false)
+	return &code
+}
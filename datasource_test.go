@@ -0,0 +1,114 @@
+package gomoji_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const emojiDatasourceSample = `[
+  {
+    "name": "WAVING HAND SIGN",
+    "unified": "1F44B",
+    "non_qualified": null,
+    "image": "1f44b.png",
+    "sheet_x": 14,
+    "sheet_y": 48,
+    "short_name": "wave",
+    "short_names": ["wave"],
+    "category": "People & Body",
+    "subcategory": "hand-fingers-open",
+    "sort_order": 2,
+    "added_in": "0.6",
+    "skin_variations": {
+      "1F3FB": {
+        "unified": "1F44B-1F3FB",
+        "non_qualified": null,
+        "image": "1f44b-1f3fb.png",
+        "sheet_x": 14,
+        "sheet_y": 49,
+        "added_in": "1.0"
+      }
+    }
+  },
+  {
+    "name": "HEAVY BLACK HEART",
+    "unified": "2764-FE0F",
+    "non_qualified": "2764",
+    "image": "2764-fe0f.png",
+    "sheet_x": 59,
+    "sheet_y": 10,
+    "short_name": "heart",
+    "short_names": ["heart"],
+    "category": "Smileys & Emotion",
+    "subcategory": "emotion",
+    "sort_order": 1,
+    "added_in": "0.6"
+  }
+]`
+
+func TestLoadEmojiDatasource(t *testing.T) {
+	d, err := gomoji.LoadEmojiDatasource(strings.NewReader(emojiDatasourceSample))
+	if err != nil {
+		t.Fatalf("LoadEmojiDatasource() error = %v", err)
+	}
+
+	emojis := d.Emojis()
+	if want := []string{"❤️", "❤", "👋", "👋🏻"}; !reflect.DeepEqual(characters(emojis), want) {
+		t.Fatalf("Emojis() = %q, want %q", characters(emojis), want)
+	}
+	if got := emojis[1].Status; got != gomoji.StatusUnqualified {
+		t.Errorf("Status of the non-qualified spelling = %v, want %v", got, gomoji.StatusUnqualified)
+	}
+
+	toned := emojis[3]
+	if toned.Name != "waving hand sign: light skin tone" || toned.SubGroup != "hand-fingers-open" {
+		t.Errorf("toned variant = %+v", toned)
+	}
+	if md, _ := d.Metadata(toned); md.SheetX != 14 || md.SheetY != 49 {
+		t.Errorf("Metadata() = %+v, want the sheet coordinates", md)
+	}
+
+	m := gomoji.New(gomoji.WithDataset(d))
+	if got := m.ReplaceWithSlug("hi 👋🏻 ❤"); got != "hi waving-hand-sign-light-skin-tone heavy-black-heart" {
+		t.Errorf("ReplaceWithSlug() = %q", got)
+	}
+}
+
+func TestLoadEmojiDatasourceInvalid(t *testing.T) {
+	for _, doc := range []string{`[1]`, `[{"unified": "1F44B", "added_in": "new"}]`} {
+		if _, err := gomoji.LoadEmojiDatasource(strings.NewReader(doc)); !errors.Is(err, gomoji.ErrInvalidDataset) {
+			t.Errorf("LoadEmojiDatasource(%s) error = %v, want %v", doc, err, gomoji.ErrInvalidDataset)
+		}
+	}
+}
+
+func TestWriteEmojiDatasource(t *testing.T) {
+	d, err := gomoji.LoadEmojiDatasource(strings.NewReader(emojiDatasourceSample))
+	if err != nil {
+		t.Fatalf("LoadEmojiDatasource() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := gomoji.WriteEmojiDatasource(&buf, d); err != nil {
+		t.Fatalf("WriteEmojiDatasource() error = %v", err)
+	}
+
+	d2, err := gomoji.LoadEmojiDatasource(&buf)
+	if err != nil {
+		t.Fatalf("LoadEmojiDatasource() error = %v", err)
+	}
+	if !reflect.DeepEqual(d2.Emojis(), d.Emojis()) {
+		t.Errorf("round trip = %+v, want %+v", d2.Emojis(), d.Emojis())
+	}
+	for _, em := range d.Emojis() {
+		md, _ := d.Metadata(em)
+		if md2, _ := d2.Metadata(em); !reflect.DeepEqual(md2, md) {
+			t.Errorf("round trip Metadata(%q) = %+v, want %+v", em.Character, md2, md)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package gomoji
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	firstTag  = '\U000E0020'
+	cancelTag = '\U000E007F'
+)
+
+// Role is the role of a component in an emoji sequence.
+type Role uint8
+
+// Component roles.
+const (
+	// RoleBase is the leading emoji of a sequence that is not a person, e.g. 🏃 in 🏃‍♀️ or 🏳️ in 🏳️‍🌈.
+	RoleBase Role = iota
+	// RolePerson is a person such as 🧑, 👩 or 👦, e.g. in 👩‍⚕️ or 👨‍👩‍👦.
+	RolePerson
+	// RoleObject is any other emoji joined to the sequence, e.g. ⚕️ in 👩‍⚕️ or 🔥 in ❤️‍🔥.
+	RoleObject
+	// RoleSkinTone is a skin tone modifier, e.g. 🏽 in 👍🏽.
+	RoleSkinTone
+	// RoleHairStyle is a hair style component, e.g. 🦰 in 👩‍🦰.
+	RoleHairStyle
+	// RoleGender is a gender sign, e.g. ♀️ in 🏃‍♀️.
+	RoleGender
+	// RoleDirection is the direction arrow, e.g. ➡️ in 🏃‍➡️.
+	RoleDirection
+	// RoleRegionalIndicator is one of the two letters of a flag, e.g. 🇺 in 🇺🇸.
+	RoleRegionalIndicator
+	// RoleTag is the tag sequence of a subdivision flag, e.g. the tags for "gbeng" in 🏴󠁧󠁢󠁥󠁮󠁧󠁿.
+	RoleTag
+)
+
+var roleNames = [...]string{
+	RoleBase:              "base",
+	RolePerson:            "person",
+	RoleObject:            "object",
+	RoleSkinTone:          "skin tone",
+	RoleHairStyle:         "hair style",
+	RoleGender:            "gender",
+	RoleDirection:         "direction",
+	RoleRegionalIndicator: "regional indicator",
+	RoleTag:               "tag",
+}
+
+// Component is a constituent emoji of an emoji sequence together with its role in the sequence.
+type Component struct {
+	Emoji
+	Role Role
+}
+
+// String returns the name of the role, e.g. "skin tone".
+func (r Role) String() string {
+	if int(r) >= len(roleNames) {
+		return ""
+	}
+
+	return roleNames[r]
+}
+
+// Decompose splits a ZWJ, modifier, flag or tag sequence into its constituent emojis, so that a client that
+// cannot render the sequence can fall back to them, e.g. ❤️‍🔥 into ❤️ and 🔥, or 👩🏽‍🦰 into 👩, 🏽 and 🦰.
+// The components are canonical spellings. Skin tone modifiers and tag sequences are not in the emoji list
+// on their own, so their components are filled in by Decompose. Keycaps and emojis that are not sequences
+// are returned as a single component.
+func Decompose(e Emoji) []Component {
+	if r, _ := utf8.DecodeRuneInString(e.Character); isRegionalIndicator(r) {
+		var components []Component
+		for _, ri := range e.Character {
+			components = append(components, Component{Emoji: componentEmoji(string(ri)), Role: RoleRegionalIndicator})
+		}
+		return components
+	}
+
+	var components []Component
+	for i, part := range strings.Split(e.Character, string(zeroWidthJoiner)) {
+		base, suffix := splitModifiers(part)
+		components = append(components, Component{Emoji: componentEmoji(base), Role: componentRole(base, i)})
+
+		if suffix == "" {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(suffix); isSkinToneModifier(r) {
+			components = append(components, Component{Emoji: skinToneEmoji(SkinTone(r - firstSkinToneModifier + 1)), Role: RoleSkinTone})
+		} else {
+			components = append(components, Component{Emoji: tagEmoji(suffix), Role: RoleTag})
+		}
+	}
+
+	return components
+}
+
+// Compose joins the components into an emoji sequence and returns the emoji, in canonical spelling. Skin tone
+// modifiers, regional indicators and tags are attached directly, other components with the zero width joiner.
+// It is the inverse of Decompose. If the sequence is not in the emoji list, it returns the gomoji.ErrEmojiNotFound error.
+func Compose(components []Component) (Emoji, error) {
+	var buf strings.Builder
+	for i, c := range components {
+		if i > 0 && c.Role != RoleSkinTone && c.Role != RoleTag && c.Role != RoleRegionalIndicator {
+			buf.WriteRune(zeroWidthJoiner)
+		}
+		buf.WriteString(c.Character)
+	}
+
+	em, ok := defaultMatcher.lookup(buf.String())
+	if !ok {
+		return Emoji{}, ErrEmojiNotFound
+	}
+
+	return em, nil
+}
+
+// splitModifiers splits a ZWJ sequence part into the emoji and the trailing skin tone modifier or tag sequence.
+func splitModifiers(part string) (string, string) {
+	if i := strings.IndexFunc(part, func(r rune) bool {
+		return isSkinToneModifier(r) || isTag(r)
+	}); i > 0 {
+		return part[:i], part[i:]
+	}
+
+	return part, ""
+}
+
+// componentRole returns the role of the ZWJ sequence part with the given index.
+func componentRole(s string, index int) Role {
+	r, _ := utf8.DecodeRuneInString(s)
+	if _, ok := neutralPersons[r]; ok {
+		return RolePerson
+	}
+
+	switch {
+	case index == 0:
+		return RoleBase
+	case r == femaleSign || r == maleSign:
+		return RoleGender
+	case r == rightArrow:
+		return RoleDirection
+	case r >= firstHairStyleComponent && r <= firstHairStyleComponent+3:
+		return RoleHairStyle
+	default:
+		return RoleObject
+	}
+}
+
+// componentEmoji returns the canonical spelling of the component, or an emoji with just the Character
+// and CodePoints if it is not in the emoji list.
+func componentEmoji(s string) Emoji {
+	if em, ok := defaultMatcher.lookup(s); ok {
+		return em
+	}
+
+	return Emoji{Character: s, CodePoints: []rune(s)}
+}
+
+// skinToneEmoji describes the skin tone modifier as an emoji component, as listed in emoji-test.txt.
+func skinToneEmoji(t SkinTone) Emoji {
+	r := t.Modifier()
+
+	return Emoji{
+		Slug:        strings.ReplaceAll(t.String(), " ", "-"),
+		Character:   string(r),
+		UnicodeName: "E1.0 " + t.String(),
+		CodePoint:   strings.ToUpper(strconv.FormatInt(int64(r), 16)),
+		Group:       "Component",
+		SubGroup:    "skin-tone",
+		Name:        t.String(),
+		Version:     Version{Major: 1},
+		CodePoints:  []rune{r},
+		Status:      StatusComponent,
+	}
+}
+
+// tagEmoji describes the tag sequence as an emoji component. Its name is the tag text, e.g. "gbeng".
+func tagEmoji(s string) Emoji {
+	var text strings.Builder
+	for _, r := range s {
+		if r != cancelTag {
+			text.WriteRune(r - firstTag + ' ')
+		}
+	}
+
+	return Emoji{
+		Slug:       "tag-" + text.String(),
+		Character:  s,
+		CodePoint:  codePointKey([]rune(s)),
+		Name:       text.String(),
+		CodePoints: []rune(s),
+	}
+}
+
+func isTag(r rune) bool {
+	return r >= firstTag && r <= cancelTag
+}
@@ -0,0 +1,147 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+type component struct {
+	Character string
+	Role      gomoji.Role
+}
+
+func TestDecompose(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  []component
+	}{
+		{
+			name:  "single emoji",
+			emoji: "🦋",
+			want:  []component{{"🦋", gomoji.RoleBase}},
+		},
+		{
+			name:  "zwj sequence",
+			emoji: "❤️‍🔥",
+			want:  []component{{"❤️", gomoji.RoleBase}, {"🔥", gomoji.RoleObject}},
+		},
+		{
+			name:  "person with skin tone and hair style",
+			emoji: "👩🏽‍🦰",
+			want: []component{
+				{"👩", gomoji.RolePerson},
+				{"🏽", gomoji.RoleSkinTone},
+				{"🦰", gomoji.RoleHairStyle},
+			},
+		},
+		{
+			name:  "gender and direction",
+			emoji: "🏃🏿‍♀️‍➡️",
+			want: []component{
+				{"🏃", gomoji.RoleBase},
+				{"🏿", gomoji.RoleSkinTone},
+				{"♀️", gomoji.RoleGender},
+				{"➡️", gomoji.RoleDirection},
+			},
+		},
+		{
+			name:  "family",
+			emoji: "👨‍👩‍👦",
+			want: []component{
+				{"👨", gomoji.RolePerson},
+				{"👩", gomoji.RolePerson},
+				{"👦", gomoji.RolePerson},
+			},
+		},
+		{
+			name:  "flag",
+			emoji: "🇺🇸",
+			want:  []component{{"🇺", gomoji.RoleRegionalIndicator}, {"🇸", gomoji.RoleRegionalIndicator}},
+		},
+		{
+			name:  "subdivision flag",
+			emoji: "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
+			want:  []component{{"🏴", gomoji.RoleBase}, {"\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", gomoji.RoleTag}},
+		},
+		{
+			name:  "keycap",
+			emoji: "#️⃣",
+			want:  []component{{"#️⃣", gomoji.RoleBase}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+
+			var got []component
+			for _, c := range gomoji.Decompose(em) {
+				got = append(got, component{c.Character, c.Role})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decompose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecomposeComponents(t *testing.T) {
+	em, err := gomoji.GetInfo("🏴󠁧󠁢󠁥󠁮󠁧󠁿")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+	if got := gomoji.Decompose(em)[1].Name; got != "gbeng" {
+		t.Errorf("tag Name = %q, want %q", got, "gbeng")
+	}
+
+	em, err = gomoji.GetInfo("👍🏽")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+	tone := gomoji.Decompose(em)[1]
+	if tone.Name != "medium skin tone" || tone.Status != gomoji.StatusComponent || tone.CodePoint != "1F3FD" {
+		t.Errorf("skin tone = %+v", tone.Emoji)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	heart, _ := gomoji.GetInfo("❤")
+	fire, _ := gomoji.GetInfo("🔥")
+	butterfly, _ := gomoji.GetInfo("🦋")
+
+	got, err := gomoji.Compose([]gomoji.Component{{Emoji: heart, Role: gomoji.RoleBase}, {Emoji: fire, Role: gomoji.RoleObject}})
+	if err != nil {
+		t.Fatalf("Compose() error = %v", err)
+	}
+	if want := "❤️‍🔥"; got.Character != want {
+		t.Errorf("Compose() = %q, want %q", got.Character, want)
+	}
+
+	_, err = gomoji.Compose([]gomoji.Component{{Emoji: butterfly, Role: gomoji.RoleBase}, {Emoji: fire, Role: gomoji.RoleObject}})
+	if !errors.Is(err, gomoji.ErrEmojiNotFound) {
+		t.Errorf("Compose() error = %v, want %v", err, gomoji.ErrEmojiNotFound)
+	}
+
+	if _, err := gomoji.Compose(nil); !errors.Is(err, gomoji.ErrEmojiNotFound) {
+		t.Errorf("Compose(nil) error = %v, want %v", err, gomoji.ErrEmojiNotFound)
+	}
+}
+
+func TestComposeRoundTrip(t *testing.T) {
+	for _, entry := range gomoji.AllEntries() {
+		if entry.Status == gomoji.StatusUnknown {
+			continue
+		}
+
+		got, err := gomoji.Compose(gomoji.Decompose(entry.Emoji))
+		if err != nil || got.Character != entry.Character {
+			t.Errorf("Compose(Decompose(%q)) = %q, %v", entry.Character, got.Character, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package gomoji
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// emojibaseEntry is an emoji in the data.json files of emojibase-data, with its toned variants in Skins.
+type emojibaseEntry struct {
+	Label      string           `json:"label"`
+	Hexcode    string           `json:"hexcode"`
+	Emoji      string           `json:"emoji"`
+	Tags       []string         `json:"tags,omitempty"`
+	Group      *int             `json:"group,omitempty"`
+	Subgroup   *int             `json:"subgroup,omitempty"`
+	Order      int              `json:"order,omitempty"`
+	Version    float64          `json:"version"`
+	Tone       interface{}      `json:"tone,omitempty"`
+	Shortcodes []string         `json:"shortcodes,omitempty"`
+	Skins      []emojibaseEntry `json:"skins,omitempty"`
+}
+
+// EmojibaseGroups is the numbering of the groups and subgroups in the meta/groups.json file of emojibase-data.
+// The data.json files refer to groups and subgroups by these numbers only.
+type EmojibaseGroups struct {
+	Groups    map[int]string `json:"groups"`
+	SubGroups map[int]string `json:"subgroups"`
+}
+
+// LoadEmojibaseGroups parses the meta/groups.json file of emojibase-data. If the JSON is malformed, it returns
+// an error wrapping the gomoji.ErrInvalidDataset error.
+func LoadEmojibaseGroups(r io.Reader) (*EmojibaseGroups, error) {
+	var g EmojibaseGroups
+	if err := decodeJSON(r, &g); err != nil {
+		return nil, err
+	}
+
+	return &g, nil
+}
+
+// LoadEmojibase builds a Dataset from a data.json file of emojibase-data, such as en/data.json, ordered by
+// the order field. The skins become the toned variants of the emojis, and the tags and shortcodes, if the
+// file has them, become the Metadata. The group and subgroup numbers are resolved with the groups, which
+// come from the meta/groups.json file of the same release; if groups is nil, Group and SubGroup are left
+// empty. If the JSON is malformed, it returns an error wrapping the gomoji.ErrInvalidDataset error.
+func LoadEmojibase(r io.Reader, groups *EmojibaseGroups) (*Dataset, error) {
+	var entries []emojibaseEntry
+	if err := decodeJSON(r, &entries); err != nil {
+		return nil, err
+	}
+	sortByOrder(entries)
+
+	d := &Dataset{}
+	for _, entry := range entries {
+		em, err := entry.emoji(groups)
+		if err != nil {
+			return nil, err
+		}
+		d.add(em, Metadata{Shortcodes: entry.Shortcodes, Tags: entry.Tags})
+
+		for _, skin := range entry.Skins {
+			toned, err := skin.emoji(groups)
+			if err != nil {
+				return nil, err
+			}
+			toned.Group, toned.SubGroup = em.Group, em.SubGroup
+			d.add(toned, Metadata{Shortcodes: skin.Shortcodes, Tags: skin.Tags})
+		}
+	}
+
+	return d, nil
+}
+
+// WriteEmojibase writes the fully-qualified emojis of the dataset in the data.json format of emojibase-data,
+// with the toned variants as skins. The groups and subgroups are numbered with the groups, which come from
+// the meta/groups.json file of emojibase-data; if groups is nil or lacks one, the number is left out.
+// Emojis without imported shortcodes get their GitHub shortcodes.
+func WriteEmojibase(w io.Writer, d *Dataset, groups *EmojibaseGroups) error {
+	var groupNumbers, subGroupNumbers map[string]int
+	if groups != nil {
+		groupNumbers, subGroupNumbers = numbersByKey(groups.Groups), numbersByKey(groups.SubGroups)
+	}
+
+	entries := []emojibaseEntry{}
+	order := 0
+	for _, f := range d.toneFamilies() {
+		order++
+		entry := newEmojibaseEntry(d, f.base, order, groupNumbers, subGroupNumbers)
+		for _, toned := range f.toned {
+			order++
+			skin := newEmojibaseEntry(d, toned, order, groupNumbers, subGroupNumbers)
+			skin.Group, skin.Subgroup, skin.Tags = nil, nil, nil
+
+			tones := SkinTones(toned)
+			if len(tones) == 1 {
+				skin.Tone = int(tones[0])
+			} else {
+				ints := make([]int, 0, len(tones))
+				for _, tone := range tones {
+					ints = append(ints, int(tone))
+				}
+				skin.Tone = ints
+			}
+			entry.Skins = append(entry.Skins, skin)
+		}
+		entries = append(entries, entry)
+	}
+
+	return encodeJSON(w, entries)
+}
+
+func newEmojibaseEntry(d *Dataset, em Emoji, order int, groupNumbers, subGroupNumbers map[string]int) emojibaseEntry {
+	md, _ := d.Metadata(em)
+	version, _ := strconv.ParseFloat(em.Version.String(), 64)
+
+	entry := emojibaseEntry{
+		Label:      em.Name,
+		Hexcode:    hexcode(em.CodePoints, false),
+		Emoji:      em.Character,
+		Tags:       md.Tags,
+		Order:      order,
+		Version:    version,
+		Shortcodes: d.shortcodes(em, GitHubShortcodes),
+	}
+	if g, ok := groupNumbers[emojibaseKey(em.Group)]; ok {
+		entry.Group = &g
+	}
+	if sg, ok := subGroupNumbers[emojibaseKey(em.SubGroup)]; ok {
+		entry.Subgroup = &sg
+	}
+
+	return entry
+}
+
+// emoji converts the entry into an Emoji without its skins.
+func (e emojibaseEntry) emoji(groups *EmojibaseGroups) (Emoji, error) {
+	version, err := ParseVersion(strconv.FormatFloat(e.Version, 'f', -1, 64))
+	if err != nil {
+		return Emoji{}, fmt.Errorf("%w: %s: %v", ErrInvalidDataset, e.Hexcode, err)
+	}
+	runes := []rune(e.Emoji)
+	if len(runes) == 0 {
+		if runes, err = parseCodePoints(e.Hexcode); err != nil {
+			return Emoji{}, fmt.Errorf("%w: %s: %v", ErrInvalidDataset, e.Hexcode, err)
+		}
+	}
+
+	em := newImportedEmoji(runes, e.Label, version, StatusFullyQualified)
+	if groups != nil && e.Group != nil {
+		em.Group = unicodeGroupName(groups.Groups[*e.Group], groupOrder)
+	}
+	if groups != nil && e.Subgroup != nil {
+		em.SubGroup = unicodeGroupName(groups.SubGroups[*e.Subgroup], subGroupOrder)
+	}
+
+	return em, nil
+}
+
+// emojibaseKey returns the key emojibase uses for a group or subgroup name of emoji-test.txt, e.g.
+// "smileys-emotion" for "Smileys & Emotion" and "sky-weather" for "sky & weather".
+func emojibaseKey(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.ToLower(name), " & ", "-"), " ", "-")
+}
+
+// unicodeGroupName returns the name of emoji-test.txt the emojibase key stands for, or the key itself if the
+// name is unknown.
+func unicodeGroupName(key string, ranks map[string]int) string {
+	for name := range ranks {
+		if emojibaseKey(name) == key {
+			return name
+		}
+	}
+
+	return key
+}
+
+// numbersByKey inverts the numbering of emojibase groups or subgroups.
+func numbersByKey(names map[int]string) map[string]int {
+	numbers := make(map[string]int, len(names))
+	for n, key := range names {
+		numbers[key] = n
+	}
+
+	return numbers
+}
+
+// sortByOrder sorts the entries by their order field, keeping entries without one at the end.
+func sortByOrder(entries []emojibaseEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].Order, entries[j].Order
+		if a == 0 || b == 0 {
+			return a != 0 && b == 0
+		}
+		return a < b
+	})
+}
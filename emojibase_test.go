@@ -0,0 +1,174 @@
+package gomoji_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const emojibaseSample = `[
+  {
+    "label": "waving hand",
+    "hexcode": "1F44B",
+    "emoji": "👋",
+    "tags": ["hand", "wave"],
+    "group": 1,
+    "subgroup": 15,
+    "order": 2,
+    "version": 0.6,
+    "shortcodes": ["wave"],
+    "skins": [
+      {
+        "label": "waving hand: light skin tone",
+        "hexcode": "1F44B-1F3FB",
+        "emoji": "👋🏻",
+        "order": 3,
+        "version": 1,
+        "tone": 1,
+        "shortcodes": ["wave_tone1"]
+      }
+    ]
+  },
+  {
+    "label": "red heart",
+    "hexcode": "2764",
+    "emoji": "❤️",
+    "tags": ["heart", "love"],
+    "group": 0,
+    "subgroup": 100,
+    "order": 1,
+    "version": 0.6,
+    "shortcodes": ["heart"]
+  }
+]`
+
+// emojibaseGroupsSample numbers the subgroups unlike their positions in emoji-test.txt.
+const emojibaseGroupsSample = `{
+  "groups": {"0": "smileys-emotion", "1": "people-body", "2": "component"},
+  "hierarchy": {"0": [100], "1": [15]},
+  "subgroups": {"15": "hand-fingers-open", "100": "heart"}
+}`
+
+func loadEmojibaseGroups(t *testing.T) *gomoji.EmojibaseGroups {
+	t.Helper()
+
+	groups, err := gomoji.LoadEmojibaseGroups(strings.NewReader(emojibaseGroupsSample))
+	if err != nil {
+		t.Fatalf("LoadEmojibaseGroups() error = %v", err)
+	}
+
+	return groups
+}
+
+func TestLoadEmojibase(t *testing.T) {
+	d, err := gomoji.LoadEmojibase(strings.NewReader(emojibaseSample), loadEmojibaseGroups(t))
+	if err != nil {
+		t.Fatalf("LoadEmojibase() error = %v", err)
+	}
+
+	emojis := d.Emojis()
+	if want := []string{"❤️", "👋", "👋🏻"}; !reflect.DeepEqual(characters(emojis), want) {
+		t.Fatalf("Emojis() = %q, want %q", characters(emojis), want)
+	}
+
+	want := gomoji.Emoji{
+		Slug:        "red-heart",
+		Character:   "❤️",
+		UnicodeName: "E0.6 red heart",
+		CodePoint:   "2764 FE0F",
+		Group:       "Smileys & Emotion",
+		SubGroup:    "heart",
+		Name:        "red heart",
+		Version:     gomoji.Version{Major: 0, Minor: 6},
+		CodePoints:  []rune{0x2764, 0xFE0F},
+		Status:      gomoji.StatusFullyQualified,
+	}
+	if !reflect.DeepEqual(emojis[0], want) {
+		t.Errorf("Emojis()[0] = %+v, want %+v", emojis[0], want)
+	}
+
+	toned := emojis[2]
+	if toned.Group != "People & Body" || toned.SubGroup != "hand-fingers-open" || toned.Version != (gomoji.Version{Major: 1}) {
+		t.Errorf("toned variant = %+v", toned)
+	}
+	if md, _ := d.Metadata(toned); !reflect.DeepEqual(md.Shortcodes, []string{"wave_tone1"}) {
+		t.Errorf("Metadata() = %+v", md)
+	}
+}
+
+func TestLoadEmojibaseWithoutGroups(t *testing.T) {
+	d, err := gomoji.LoadEmojibase(strings.NewReader(emojibaseSample), nil)
+	if err != nil {
+		t.Fatalf("LoadEmojibase() error = %v", err)
+	}
+
+	for _, em := range d.Emojis() {
+		if em.Group != "" || em.SubGroup != "" {
+			t.Errorf("%q group = %q, subgroup = %q, want them empty", em.Character, em.Group, em.SubGroup)
+		}
+	}
+}
+
+func TestLoadEmojibaseInvalid(t *testing.T) {
+	for _, doc := range []string{`[{"label": "x"`, `[{"label": "x", "hexcode": "ZZ", "version": 1}]`} {
+		if _, err := gomoji.LoadEmojibase(strings.NewReader(doc), nil); !errors.Is(err, gomoji.ErrInvalidDataset) {
+			t.Errorf("LoadEmojibase(%s) error = %v, want %v", doc, err, gomoji.ErrInvalidDataset)
+		}
+	}
+
+	if _, err := gomoji.LoadEmojibaseGroups(strings.NewReader(`{"groups": {"x": "flags"}}`)); !errors.Is(err, gomoji.ErrInvalidDataset) {
+		t.Errorf("LoadEmojibaseGroups() error = %v, want %v", err, gomoji.ErrInvalidDataset)
+	}
+}
+
+func TestWriteEmojibase(t *testing.T) {
+	groups := loadEmojibaseGroups(t)
+	d, err := gomoji.LoadEmojibase(strings.NewReader(emojibaseSample), groups)
+	if err != nil {
+		t.Fatalf("LoadEmojibase() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := gomoji.WriteEmojibase(&buf, d, groups); err != nil {
+		t.Fatalf("WriteEmojibase() error = %v", err)
+	}
+	assertSameJSON(t, buf.Bytes(), `[
+  {
+    "label": "red heart",
+    "hexcode": "2764",
+    "emoji": "❤️",
+    "tags": ["heart", "love"],
+    "group": 0,
+    "subgroup": 100,
+    "order": 1,
+    "version": 0.6,
+    "shortcodes": ["heart"]
+  },
+  {
+    "label": "waving hand",
+    "hexcode": "1F44B",
+    "emoji": "👋",
+    "tags": ["hand", "wave"],
+    "group": 1,
+    "subgroup": 15,
+    "order": 2,
+    "version": 0.6,
+    "shortcodes": ["wave"],
+    "skins": [
+      {
+        "label": "waving hand: light skin tone",
+        "hexcode": "1F44B-1F3FB",
+        "emoji": "👋🏻",
+        "order": 3,
+        "version": 1,
+        "tone": 1,
+        "shortcodes": ["wave_tone1"]
+      }
+    ]
+  }
+]`)
+}
@@ -0,0 +1,65 @@
+package gomoji
+
+import "sort"
+
+// Entry is an emoji together with all its spellings. Spellings of an emoji differ only in variation
+// selectors, e.g. #️⃣ and #⃣, and share the slug.
+type Entry struct {
+	// Emoji is the canonical spelling.
+	Emoji
+	// Spellings are all spellings of the emoji, including the canonical one, preferred one first.
+	Spellings []Emoji
+}
+
+// AllEntries returns one Entry per emoji, ordered like AllEmojis. Unlike AllEmojis, it lists every emoji once,
+// with its alternative spellings attached, which suits emoji pickers.
+func AllEntries() []Entry {
+	return defaultMatcher.AllEntries()
+}
+
+// CanonicalForm returns the canonical spelling of the provided emoji. It accepts any spelling GetInfoLenient
+// accepts, e.g. "❤", "❤︎" and "❤️" all result in "❤️". If the emoji was not found, it returns the
+// gomoji.ErrStrNotEmoji error.
+func CanonicalForm(emoji string) (string, error) {
+	return defaultMatcher.CanonicalForm(emoji)
+}
+
+// AllEntries returns one Entry per emoji of the Matcher. See the package-level AllEntries.
+func (m *Matcher) AllEntries() []Entry {
+	preferred := m.canonicals.get(m.emojis).preferred
+	emojis := m.order.get(m.emojis, m.positions)
+
+	var entries []Entry
+	index := make(map[string]int, len(preferred))
+	for _, em := range emojis {
+		key := withoutVariationSelectors(em.Character)
+		if preferred[key] == em.Character {
+			index[key] = len(entries)
+			entries = append(entries, Entry{Emoji: em})
+		}
+	}
+
+	for _, em := range emojis {
+		entry := &entries[index[withoutVariationSelectors(em.Character)]]
+		entry.Spellings = append(entry.Spellings, em)
+	}
+
+	for _, entry := range entries {
+		spellings := entry.Spellings
+		sort.SliceStable(spellings, func(i, j int) bool {
+			return preferSpelling(m.emojis, spellings[i].Character, spellings[j].Character)
+		})
+	}
+
+	return entries
+}
+
+// CanonicalForm returns the canonical spelling of the provided emoji. See the package-level CanonicalForm.
+func (m *Matcher) CanonicalForm(emoji string) (string, error) {
+	em, ok := m.lookup(emoji)
+	if !ok {
+		return "", ErrStrNotEmoji
+	}
+
+	return em.Character, nil
+}
@@ -0,0 +1,132 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestAllEntries(t *testing.T) {
+	entries := gomoji.AllEntries()
+
+	seen := make(map[string]bool)
+	var spellings int
+	for _, entry := range entries {
+		if len(entry.Spellings) == 0 || entry.Spellings[0].Character != entry.Character {
+			t.Fatalf("entry %q: first spelling = %v, want the canonical one", entry.Character, entry.Spellings)
+		}
+		for _, em := range entry.Spellings {
+			if seen[em.Character] {
+				t.Fatalf("spelling %q is listed twice", em.Character)
+			}
+			seen[em.Character] = true
+		}
+		spellings += len(entry.Spellings)
+	}
+
+	if got, want := spellings, len(gomoji.AllEmojis()); got != want {
+		t.Errorf("number of spellings = %d, want %d", got, want)
+	}
+	if len(entries) >= spellings {
+		t.Errorf("len(AllEntries()) = %d, want fewer than %d", len(entries), spellings)
+	}
+}
+
+func TestAllEntriesSpellings(t *testing.T) {
+	tests := []struct {
+		name      string
+		character string
+		want      []string
+	}{
+		{
+			name:      "keycap",
+			character: "#️⃣",
+			want:      []string{"#️⃣", "#⃣"},
+		},
+		{
+			name:      "zwj sequence",
+			character: "🏃‍♀️‍➡️",
+			want: []string{
+				"🏃‍♀️‍➡️",
+				"🏃‍♀‍➡️",
+				"🏃‍♀️‍➡",
+				"🏃‍♀‍➡",
+			},
+		},
+		{
+			name:      "single spelling",
+			character: "🦋",
+			want:      []string{"🦋"},
+		},
+	}
+
+	entries := make(map[string]gomoji.Entry)
+	for _, entry := range gomoji.AllEntries() {
+		entries[entry.Character] = entry
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := entries[tt.character]
+			if !ok {
+				t.Fatalf("no entry for %q", tt.character)
+			}
+
+			var got []string
+			for _, em := range entry.Spellings {
+				got = append(got, em.Character)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Spellings = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalForm(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "canonical spelling",
+			emoji: "❤️",
+			want:  "❤️",
+		},
+		{
+			name:  "unqualified spelling",
+			emoji: "❤",
+			want:  "❤️",
+		},
+		{
+			name:  "text presentation spelling",
+			emoji: "❤︎",
+			want:  "❤️",
+		},
+		{
+			name:  "minimally qualified zwj sequence",
+			emoji: "🏃‍♀️‍➡",
+			want:  "🏃‍♀️‍➡️",
+		},
+		{
+			name:    "not emoji",
+			emoji:   "a",
+			wantErr: gomoji.ErrStrNotEmoji,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.CanonicalForm(tt.emoji)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CanonicalForm() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CanonicalForm() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
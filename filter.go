@@ -0,0 +1,151 @@
+package gomoji
+
+import (
+	"io"
+	"strings"
+)
+
+const filterReadSize = 4096
+
+// NewRemovingWriter returns an io.WriteCloser that removes all emojis from the data written to it
+// before passing it on to w. The output is identical to RemoveEmojis applied to the whole data,
+// regardless of how it is split across Write calls. Close must be called to flush the last grapheme
+// cluster; it does not close w.
+func NewRemovingWriter(w io.Writer) io.WriteCloser {
+	return defaultMatcher.NewRemovingWriter(w)
+}
+
+// NewReplacingWriter returns an io.WriteCloser that replaces all emojis in the data written to it
+// with the result of the replacerFn function before passing it on to w. The output is identical to
+// ReplaceEmojisWithFunc applied to the whole data, regardless of how it is split across Write calls.
+// Close must be called to flush the last grapheme cluster; it does not close w.
+func NewReplacingWriter(w io.Writer, replacer replacerFn) io.WriteCloser {
+	return defaultMatcher.NewReplacingWriter(w, replacer)
+}
+
+// NewRemovingReader returns an io.Reader that reads from r and removes all emojis from the data.
+func NewRemovingReader(r io.Reader) io.Reader {
+	return defaultMatcher.NewRemovingReader(r)
+}
+
+// NewReplacingReader returns an io.Reader that reads from r and replaces all emojis with the result
+// of the replacerFn function. The output is identical to ReplaceEmojisWithFunc applied to everything r returns.
+func NewReplacingReader(r io.Reader, replacer replacerFn) io.Reader {
+	return defaultMatcher.NewReplacingReader(r, replacer)
+}
+
+// NewRemovingWriter returns an io.WriteCloser that removes all emojis of the Matcher from the data written to it
+// before passing it on to w. See the package-level NewRemovingWriter.
+func (m *Matcher) NewRemovingWriter(w io.Writer) io.WriteCloser {
+	return m.NewReplacingWriter(w, nil)
+}
+
+// NewReplacingWriter returns an io.WriteCloser that replaces all emojis of the Matcher in the data written to it
+// with the result of the replacerFn function before passing it on to w. See the package-level NewReplacingWriter.
+func (m *Matcher) NewReplacingWriter(w io.Writer, replacer replacerFn) io.WriteCloser {
+	return &replacingWriter{
+		w: w,
+		f: filter{m: m, replacer: replacer},
+	}
+}
+
+// NewRemovingReader returns an io.Reader that reads from r and removes all emojis of the Matcher from the data.
+func (m *Matcher) NewRemovingReader(r io.Reader) io.Reader {
+	return m.NewReplacingReader(r, nil)
+}
+
+// NewReplacingReader returns an io.Reader that reads from r and replaces all emojis of the Matcher with the result
+// of the replacerFn function. See the package-level NewReplacingReader.
+func (m *Matcher) NewReplacingReader(r io.Reader, replacer replacerFn) io.Reader {
+	return &replacingReader{
+		r: r,
+		f: filter{m: m, replacer: replacer},
+	}
+}
+
+type replacingWriter struct {
+	w      io.Writer
+	f      filter
+	closed bool
+}
+
+func (rw *replacingWriter) Write(p []byte) (int, error) {
+	if rw.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	if _, err := io.WriteString(rw.w, rw.f.feed(p, false)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (rw *replacingWriter) Close() error {
+	if rw.closed {
+		return nil
+	}
+	rw.closed = true
+
+	_, err := io.WriteString(rw.w, rw.f.feed(nil, true))
+	return err
+}
+
+type replacingReader struct {
+	r   io.Reader
+	f   filter
+	out string
+	buf []byte
+	err error
+}
+
+func (rr *replacingReader) Read(p []byte) (int, error) {
+	for rr.out == "" && rr.err == nil {
+		if rr.buf == nil {
+			rr.buf = make([]byte, filterReadSize)
+		}
+
+		n, err := rr.r.Read(rr.buf)
+		rr.err = err
+		rr.out = rr.f.feed(rr.buf[:n], err != nil)
+	}
+
+	if rr.out == "" {
+		return 0, rr.err
+	}
+
+	n := copy(p, rr.out)
+	rr.out = rr.out[n:]
+
+	return n, nil
+}
+
+// filter applies ReplaceEmojisWithFunc to a stream of chunks, holding back the trailing
+// bytes that may still become part of an in-flight grapheme cluster.
+type filter struct {
+	m        *Matcher
+	replacer replacerFn
+	pending  []byte
+}
+
+// feed appends p to the pending data and returns the output for all the grapheme clusters that are complete.
+// If atEOF is true, all the pending data is flushed.
+func (f *filter) feed(p []byte, atEOF bool) string {
+	f.pending = append(f.pending, p...)
+
+	var buf strings.Builder
+	var consumed int
+	for consumed < len(f.pending) {
+		n, cluster, _ := scanGraphemes(f.pending[consumed:], atEOF)
+		if n == 0 {
+			break
+		}
+
+		f.m.replaceCluster(&buf, string(cluster), f.replacer)
+		consumed += n
+	}
+
+	f.pending = f.pending[:copy(f.pending, f.pending[consumed:])]
+
+	return strings.Map(dropVariationSelector, buf.String())
+}
@@ -0,0 +1,103 @@
+package gomoji_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/forPelevin/gomoji"
+)
+
+var filterInputs = []string{
+	"",
+	"string without emoji",
+	"1️⃣qwerty2",
+	"❤️🛶😂",
+	"🧖 hello 🦋world",
+	"🆕️ NWT H&M Corduroy Pants in 'Light Beige'",
+	"Hola, cómo estás? 😊\r\n",
+	"Family 👨‍👩‍👧‍👦 night\n\nTest 🎉\n",
+	"Check ️this ✨️ sky",
+	"go 🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F! 🇺🇸🇦",
+}
+
+func TestReplacingWriter(t *testing.T) {
+	replacer := func(e gomoji.Emoji) string {
+		return "[" + e.Slug + "️]"
+	}
+
+	for _, input := range filterInputs {
+		for _, chunkSize := range []int{1, 2, 3, 7, 64} {
+			var sb strings.Builder
+			w := gomoji.NewReplacingWriter(&sb, replacer)
+
+			for i := 0; i < len(input); i += chunkSize {
+				end := i + chunkSize
+				if end > len(input) {
+					end = len(input)
+				}
+				if _, err := w.Write([]byte(input[i:end])); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			if want := gomoji.ReplaceEmojisWithFunc(input, replacer); sb.String() != want {
+				t.Errorf("ReplacingWriter(%q, chunk %d) = %q, want %q", input, chunkSize, sb.String(), want)
+			}
+		}
+	}
+}
+
+func TestRemovingWriter(t *testing.T) {
+	for _, input := range filterInputs {
+		var sb strings.Builder
+		w := gomoji.NewRemovingWriter(&sb)
+
+		if _, err := io.Copy(w, iotest.OneByteReader(strings.NewReader(input))); err != nil {
+			t.Fatalf("Copy() error = %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+
+		if want := gomoji.RemoveEmojis(input); sb.String() != want {
+			t.Errorf("RemovingWriter(%q) = %q, want %q", input, sb.String(), want)
+		}
+	}
+}
+
+func TestReplacingReader(t *testing.T) {
+	replacer := func(e gomoji.Emoji) string {
+		return ":" + e.Slug + ":"
+	}
+
+	for _, input := range filterInputs {
+		r := gomoji.NewReplacingReader(iotest.HalfReader(iotest.OneByteReader(strings.NewReader(input))), replacer)
+
+		got, err := io.ReadAll(iotest.OneByteReader(r))
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+
+		if want := gomoji.ReplaceEmojisWithFunc(input, replacer); string(got) != want {
+			t.Errorf("ReplacingReader(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestRemovingReader(t *testing.T) {
+	for _, input := range filterInputs {
+		got, err := io.ReadAll(gomoji.NewRemovingReader(strings.NewReader(input)))
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+
+		if want := gomoji.RemoveEmojis(input); string(got) != want {
+			t.Errorf("RemovingReader(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
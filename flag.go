@@ -0,0 +1,111 @@
+package gomoji
+
+import "strings"
+
+const (
+	firstRegionalIndicator = '\U0001F1E6'
+	blackFlag              = '\U0001F3F4'
+)
+
+// FlagFromCountryCode returns the flag of the ISO 3166-1 alpha-2 region code, e.g. 🇺🇸 for "US".
+// The code is case-insensitive. If the code is malformed, it returns the gomoji.ErrInvalidCountryCode error;
+// if there is no such flag, it returns the gomoji.ErrEmojiNotFound error.
+func FlagFromCountryCode(code string) (Emoji, error) {
+	if len(code) != 2 || !isASCIIAlpha(code) {
+		return Emoji{}, ErrInvalidCountryCode
+	}
+
+	var flag strings.Builder
+	for _, c := range strings.ToUpper(code) {
+		flag.WriteRune(firstRegionalIndicator + c - 'A')
+	}
+
+	return lookupFlag(flag.String(), "country-flag")
+}
+
+// CountryCodeFromFlag returns the upper-case ISO 3166-1 alpha-2 region code of the flag, e.g. "US" for 🇺🇸.
+// Only flags in the Unicode data are accepted, so a pair of regional indicators that does not form a flag,
+// such as 🇦🇦, is rejected. If the flag is not a country flag, it returns the gomoji.ErrStrNotFlag error.
+func CountryCodeFromFlag(flag string) (string, error) {
+	em, err := lookupFlag(flag, "country-flag")
+	if err != nil {
+		return "", ErrStrNotFlag
+	}
+
+	var code strings.Builder
+	for _, r := range em.Character {
+		code.WriteRune(r - firstRegionalIndicator + 'A')
+	}
+
+	return code.String(), nil
+}
+
+// FlagFromSubdivisionCode returns the flag of the ISO 3166-2 subdivision code, e.g. 🏴󠁧󠁢󠁥󠁮󠁧󠁿 for "GB-ENG".
+// The code is case-insensitive and the hyphen is optional. If the code is malformed, it returns the
+// gomoji.ErrInvalidCountryCode error; if there is no such flag, it returns the gomoji.ErrEmojiNotFound error.
+func FlagFromSubdivisionCode(code string) (Emoji, error) {
+	code = strings.ToLower(strings.Replace(code, "-", "", 1))
+	if len(code) < 3 || len(code) > 6 || !isASCIIAlpha(code[:2]) || !isASCIIAlnum(code[2:]) {
+		return Emoji{}, ErrInvalidCountryCode
+	}
+
+	var flag strings.Builder
+	flag.WriteRune(blackFlag)
+	for _, c := range code {
+		flag.WriteRune(firstTag + c - ' ')
+	}
+	flag.WriteRune(cancelTag)
+
+	return lookupFlag(flag.String(), "subdivision-flag")
+}
+
+// SubdivisionCodeFromFlag returns the upper-case ISO 3166-2 subdivision code of the flag, e.g. "GB-ENG" for 🏴󠁧󠁢󠁥󠁮󠁧󠁿.
+// If the flag is not a subdivision flag, it returns the gomoji.ErrStrNotFlag error.
+func SubdivisionCodeFromFlag(flag string) (string, error) {
+	em, err := lookupFlag(flag, "subdivision-flag")
+	if err != nil {
+		return "", ErrStrNotFlag
+	}
+
+	var code strings.Builder
+	for _, r := range em.Character {
+		if isTag(r) && r != cancelTag {
+			code.WriteRune(r - firstTag + ' ')
+		}
+	}
+
+	id := strings.ToUpper(code.String())
+	return id[:2] + "-" + id[2:], nil
+}
+
+// lookupFlag finds the flag in the given subgroup of the Unicode data.
+func lookupFlag(s, subGroup string) (Emoji, error) {
+	em, ok := defaultMatcher.lookup(s)
+	if !ok || em.Group != "Flags" || em.SubGroup != subGroup {
+		return Emoji{}, ErrEmojiNotFound
+	}
+
+	return em, nil
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= firstRegionalIndicator && r < firstRegionalIndicator+26
+}
+
+func isASCIIAlpha(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIAlnum(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && !isASCIIAlpha(string(c)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+package gomoji_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestFlagFromCountryCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "upper case",
+			code: "US",
+			want: "🇺🇸",
+		},
+		{
+			name: "lower case",
+			code: "de",
+			want: "🇩🇪",
+		},
+		{
+			name:    "not a flag",
+			code:    "AA",
+			wantErr: gomoji.ErrEmojiNotFound,
+		},
+		{
+			name:    "too long",
+			code:    "USA",
+			wantErr: gomoji.ErrInvalidCountryCode,
+		},
+		{
+			name:    "not letters",
+			code:    "1A",
+			wantErr: gomoji.ErrInvalidCountryCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.FlagFromCountryCode(tt.code)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FlagFromCountryCode() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Character != tt.want {
+				t.Errorf("FlagFromCountryCode() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountryCodeFromFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "country flag",
+			flag: "🇺🇸",
+			want: "US",
+		},
+		{
+			name:    "pair that is not a flag",
+			flag:    "🇦🇦",
+			wantErr: gomoji.ErrStrNotFlag,
+		},
+		{
+			name:    "lone regional indicator",
+			flag:    "🇦",
+			wantErr: gomoji.ErrStrNotFlag,
+		},
+		{
+			name:    "subdivision flag",
+			flag:    "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
+			wantErr: gomoji.ErrStrNotFlag,
+		},
+		{
+			name:    "not emoji",
+			flag:    "US",
+			wantErr: gomoji.ErrStrNotFlag,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.CountryCodeFromFlag(tt.flag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CountryCodeFromFlag() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CountryCodeFromFlag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubdivisionFlags(t *testing.T) {
+	tests := []struct {
+		code string
+		flag string
+	}{
+		{code: "GB-ENG", flag: "🏴󠁧󠁢󠁥󠁮󠁧󠁿"},
+		{code: "GB-SCT", flag: "🏴󠁧󠁢󠁳󠁣󠁴󠁿"},
+		{code: "GB-WLS", flag: "🏴󠁧󠁢󠁷󠁬󠁳󠁿"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := gomoji.FlagFromSubdivisionCode(tt.code)
+			if err != nil || got.Character != tt.flag {
+				t.Errorf("FlagFromSubdivisionCode() = %q, %v, want %q", got.Character, err, tt.flag)
+			}
+
+			code, err := gomoji.SubdivisionCodeFromFlag(tt.flag)
+			if err != nil || code != tt.code {
+				t.Errorf("SubdivisionCodeFromFlag() = %q, %v, want %q", code, err, tt.code)
+			}
+		})
+	}
+
+	if got, err := gomoji.FlagFromSubdivisionCode("gbeng"); err != nil || got.Slug != "flag-england" {
+		t.Errorf("FlagFromSubdivisionCode(gbeng) = %q, %v", got.Slug, err)
+	}
+	if _, err := gomoji.FlagFromSubdivisionCode("US-CA"); !errors.Is(err, gomoji.ErrEmojiNotFound) {
+		t.Errorf("FlagFromSubdivisionCode(US-CA) error = %v, want %v", err, gomoji.ErrEmojiNotFound)
+	}
+	if _, err := gomoji.FlagFromSubdivisionCode("G-B"); !errors.Is(err, gomoji.ErrInvalidCountryCode) {
+		t.Errorf("FlagFromSubdivisionCode(G-B) error = %v, want %v", err, gomoji.ErrInvalidCountryCode)
+	}
+	if _, err := gomoji.SubdivisionCodeFromFlag("🇺🇸"); !errors.Is(err, gomoji.ErrStrNotFlag) {
+		t.Errorf("SubdivisionCodeFromFlag(🇺🇸) error = %v, want %v", err, gomoji.ErrStrNotFlag)
+	}
+}
+
+func TestCountryFlagsRoundTrip(t *testing.T) {
+	var flags int
+	for _, em := range gomoji.AllEmojis() {
+		if em.SubGroup != "country-flag" || em.Group != "Flags" {
+			continue
+		}
+		flags++
+
+		code, err := gomoji.CountryCodeFromFlag(em.Character)
+		if err != nil {
+			t.Errorf("CountryCodeFromFlag(%q) error = %v", em.Character, err)
+			continue
+		}
+		if got, err := gomoji.FlagFromCountryCode(code); err != nil || got.Character != em.Character {
+			t.Errorf("FlagFromCountryCode(%q) = %q, %v, want %q", code, got.Character, err, em.Character)
+		}
+	}
+
+	if flags < 250 {
+		t.Errorf("number of country flags = %d, want at least 250", flags)
+	}
+}
@@ -0,0 +1,90 @@
+package gomoji
+
+import (
+	"fmt"
+	"io"
+)
+
+// gemojiEntry is an emoji in the db/emoji.json file of github/gemoji.
+type gemojiEntry struct {
+	Emoji          string   `json:"emoji"`
+	Description    string   `json:"description"`
+	Category       string   `json:"category"`
+	Aliases        []string `json:"aliases"`
+	Tags           []string `json:"tags"`
+	UnicodeVersion string   `json:"unicode_version"`
+	SkinTones      bool     `json:"skin_tones,omitempty"`
+}
+
+// LoadGemoji builds a Dataset from the db/emoji.json file of gemoji. The aliases, tags and unicode_version become
+// the Metadata of the emojis. The unicode_version is the Unicode version of the characters rather than the emoji
+// version, so the Version is taken from the bundled list and left zero for emojis missing from it. gemoji only
+// states whether an emoji supports skin tones, so its toned variants are taken from the bundled list. gemoji has
+// no subgroups, so SubGroup is left empty. If the JSON is malformed, it returns an error wrapping the
+// gomoji.ErrInvalidDataset error.
+func LoadGemoji(r io.Reader) (*Dataset, error) {
+	var entries []gemojiEntry
+	if err := decodeJSON(r, &entries); err != nil {
+		return nil, err
+	}
+
+	d := &Dataset{}
+	for _, entry := range entries {
+		unicodeVersion, err := ParseVersion(entry.UnicodeVersion)
+		if err != nil && entry.UnicodeVersion != "" {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidDataset, entry.Emoji, err)
+		}
+
+		var version Version
+		if bundled, ok := defaultMatcher.lookup(entry.Emoji); ok {
+			version = bundled.Version
+		}
+
+		em := newImportedEmoji([]rune(entry.Emoji), entry.Description, version, StatusFullyQualified)
+		em.Group = entry.Category
+		d.add(em, Metadata{Shortcodes: entry.Aliases, Tags: entry.Tags, UnicodeVersion: unicodeVersion})
+
+		if !entry.SkinTones {
+			continue
+		}
+		for tone := SkinToneLight; tone <= SkinToneDark; tone++ {
+			if toned, err := WithSkinTone(em, tone); err == nil {
+				toned.Group = entry.Category
+				d.add(toned, Metadata{})
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// WriteGemoji writes the fully-qualified emojis of the dataset in the db/emoji.json format of gemoji. Toned
+// emojis are not listed; skin_tones marks the emojis that have toned variants in the dataset. Emojis without
+// imported aliases get their GitHub shortcodes. The unicode_version is the one imported with LoadGemoji; it is
+// left empty for other emojis, since the emoji version differs from the Unicode version.
+func WriteGemoji(w io.Writer, d *Dataset) error {
+	entries := []gemojiEntry{}
+	for _, f := range d.toneFamilies() {
+		md, _ := d.Metadata(f.base)
+		entries = append(entries, gemojiEntry{
+			Emoji:          f.base.Character,
+			Description:    f.base.Name,
+			Category:       f.base.Group,
+			Aliases:        append([]string{}, d.shortcodes(f.base, GitHubShortcodes)...),
+			Tags:           append([]string{}, md.Tags...),
+			UnicodeVersion: unicodeVersion(md.UnicodeVersion),
+			SkinTones:      len(f.toned) > 0,
+		})
+	}
+
+	return encodeJSON(w, entries)
+}
+
+// unicodeVersion returns the version in the "6.1" form, or an empty string if it is zero.
+func unicodeVersion(v Version) string {
+	if v == (Version{}) {
+		return ""
+	}
+
+	return v.String()
+}
@@ -0,0 +1,132 @@
+package gomoji_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const gemojiSample = `[
+  {
+    "emoji": "😀",
+    "description": "grinning face",
+    "category": "Smileys & Emotion",
+    "aliases": ["grinning"],
+    "tags": ["smile", "happy"],
+    "unicode_version": "6.1"
+  },
+  {
+    "emoji": "👋",
+    "description": "waving hand",
+    "category": "People & Body",
+    "aliases": ["wave"],
+    "tags": ["goodbye"],
+    "unicode_version": "6.0",
+    "skin_tones": true
+  }
+]`
+
+func TestLoadGemoji(t *testing.T) {
+	d, err := gomoji.LoadGemoji(strings.NewReader(gemojiSample))
+	if err != nil {
+		t.Fatalf("LoadGemoji() error = %v", err)
+	}
+
+	emojis := d.Emojis()
+	if want := []string{"😀", "👋", "👋🏻", "👋🏼", "👋🏽", "👋🏾", "👋🏿"}; !reflect.DeepEqual(characters(emojis), want) {
+		t.Fatalf("Emojis() = %q, want %q", characters(emojis), want)
+	}
+
+	want := gomoji.Emoji{
+		Slug:        "grinning-face",
+		Character:   "😀",
+		UnicodeName: "E1.0 grinning face",
+		CodePoint:   "1F600",
+		Group:       "Smileys & Emotion",
+		Name:        "grinning face",
+		Version:     gomoji.Version{Major: 1, Minor: 0},
+		CodePoints:  []rune{0x1F600},
+		Status:      gomoji.StatusFullyQualified,
+	}
+	if !reflect.DeepEqual(emojis[0], want) {
+		t.Errorf("Emojis()[0] = %+v, want %+v", emojis[0], want)
+	}
+
+	md, ok := d.Metadata(emojis[1])
+	if want := (gomoji.Metadata{Shortcodes: []string{"wave"}, Tags: []string{"goodbye"}, UnicodeVersion: gomoji.Version{Major: 6}}); !ok || !reflect.DeepEqual(md, want) {
+		t.Errorf("Metadata() = %+v, %v, want %+v, true", md, ok, want)
+	}
+	if got := emojis[4].Name; got != "waving hand: medium skin tone" {
+		t.Errorf("Name of the toned variant = %q", got)
+	}
+}
+
+func TestLoadGemojiInvalid(t *testing.T) {
+	for _, doc := range []string{`{"emoji": "😀"}`, `[{"emoji": "😀", "unicode_version": "six"}]`} {
+		if _, err := gomoji.LoadGemoji(strings.NewReader(doc)); !errors.Is(err, gomoji.ErrInvalidDataset) {
+			t.Errorf("LoadGemoji(%s) error = %v, want %v", doc, err, gomoji.ErrInvalidDataset)
+		}
+	}
+}
+
+func TestWriteGemoji(t *testing.T) {
+	d, err := gomoji.LoadGemoji(strings.NewReader(gemojiSample))
+	if err != nil {
+		t.Fatalf("LoadGemoji() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := gomoji.WriteGemoji(&buf, d); err != nil {
+		t.Fatalf("WriteGemoji() error = %v", err)
+	}
+	assertSameJSON(t, buf.Bytes(), gemojiSample)
+}
+
+func TestWriteGemojiBundled(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gomoji.WriteGemoji(&buf, gomoji.NewDataset(gomoji.AllEmojis())); err != nil {
+		t.Fatalf("WriteGemoji() error = %v", err)
+	}
+
+	// The emoji version is not a Unicode version, so it is not written.
+	if strings.Contains(buf.String(), `"unicode_version":"1.0"`) {
+		t.Errorf("WriteGemoji() wrote emoji versions as unicode_version")
+	}
+
+	d, err := gomoji.LoadGemoji(&buf)
+	if err != nil {
+		t.Fatalf("LoadGemoji() error = %v", err)
+	}
+	for _, em := range d.Emojis() {
+		if em.Character == "🎉" {
+			if md, _ := d.Metadata(em); md.Shortcodes[0] != "tada" {
+				t.Errorf("Shortcodes = %q, want the GitHub shortcodes", md.Shortcodes)
+			}
+			if em.Version != (gomoji.Version{Major: 0, Minor: 6}) {
+				t.Errorf("Version = %v, want the bundled emoji version 0.6", em.Version)
+			}
+			return
+		}
+	}
+	t.Errorf("Emojis() has no 🎉")
+}
+
+func assertSameJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("invalid JSON %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("JSON = %s, want %s", got, want)
+	}
+}
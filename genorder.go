@@ -0,0 +1,75 @@
+//go:build ignore
+
+// genorder writes order_data.go, the positions of the emojis in emoji-test.txt that AllEmojis follows.
+// It reads the latest file from unicode.org unless -input names a local copy:
+//
+//	go run genorder.go [-input emoji-test.txt] [-output order_data.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const emojiTestURL = "https://unicode.org/Public/emoji/latest/emoji-test.txt"
+
+func main() {
+	input := flag.String("input", "", "path of emoji-test.txt; downloaded from unicode.org if empty")
+	output := flag.String("output", "order_data.go", "path of the generated file")
+	flag.Parse()
+
+	r, err := open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	d, err := gomoji.LoadEmojiTest(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genorder.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package gomoji\n\n")
+	buf.WriteString("// emojiOrder maps every spelling of emoji-test.txt to its position in the file.\n")
+	buf.WriteString("var emojiOrder = map[string]int{\n")
+	for i, em := range d.Emojis() {
+		fmt.Fprintf(&buf, "%s: %d,\n", strconv.Quote(em.Character), i)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if path != "" {
+		return os.Open(path)
+	}
+
+	resp, err := http.Get(emojiTestURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get %s: %s", emojiTestURL, resp.Status)
+	}
+
+	return resp.Body, nil
+}
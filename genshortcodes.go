@@ -0,0 +1,87 @@
+//go:build ignore
+
+// genshortcodes writes shortcode_github.go, the GitHub shortcodes of the emojis taken from the db/emoji.json file
+// of gemoji. It reads the latest file from GitHub unless -input names a local copy:
+//
+//	go run genshortcodes.go [-input emoji.json] [-output shortcode_github.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const gemojiURL = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
+
+func main() {
+	input := flag.String("input", "", "path of gemoji's db/emoji.json; downloaded from GitHub if empty")
+	output := flag.String("output", "shortcode_github.go", "path of the generated file")
+	flag.Parse()
+
+	r, err := open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	d, err := gomoji.LoadGemoji(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genshortcodes.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package gomoji\n\n")
+	buf.WriteString("// githubShortcodes lists the aliases of gemoji, the emoji database of GitHub, preferred alias first.\n")
+	buf.WriteString("var githubShortcodes = map[string][]string{\n")
+	for _, em := range d.Emojis() {
+		md, _ := d.Metadata(em)
+		if len(md.Shortcodes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "%s: {", strconv.Quote(em.Character))
+		for i, alias := range md.Shortcodes {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(strconv.Quote(alias))
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if path != "" {
+		return os.Open(path)
+	}
+
+	resp, err := http.Get(gemojiURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get %s: %s", gemojiURL, resp.Status)
+	}
+
+	return resp.Body, nil
+}
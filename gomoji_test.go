@@ -3,7 +3,6 @@ package gomoji_test
 import (
 	"fmt"
 	"reflect"
-	"sort"
 	"testing"
 
 	"github.com/forPelevin/gomoji"
@@ -498,6 +497,10 @@ func TestGetInfo(t *testing.T) {
 				CodePoint:   "0031 FE0F 20E3",
 				Group:       "Symbols",
 				SubGroup:    "keycap",
+				Name:        "keycap: 1",
+				Version:     gomoji.Version{Major: 0, Minor: 6},
+				CodePoints:  []rune{0x0031, 0xFE0F, 0x20E3},
+				Status:      gomoji.StatusFullyQualified,
 			},
 			wantErr: false,
 		},
@@ -511,6 +514,10 @@ func TestGetInfo(t *testing.T) {
 				CodePoint:   "1FAAC",
 				Group:       "Objects",
 				SubGroup:    "other-object",
+				Name:        "hamsa",
+				Version:     gomoji.Version{Major: 14, Minor: 0},
+				CodePoints:  []rune{0x1FAAC},
+				Status:      gomoji.StatusFullyQualified,
 			},
 			wantErr: false,
 		},
@@ -570,6 +577,10 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1F98B",
 					Group:       "Animals & Nature",
 					SubGroup:    "animal-bug",
+					Name:        "butterfly",
+					Version:     gomoji.Version{Major: 3, Minor: 0},
+					CodePoints:  []rune{0x1F98B},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "roll-of-paper",
@@ -578,6 +589,10 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1F9FB",
 					Group:       "Objects",
 					SubGroup:    "household",
+					Name:        "roll of paper",
+					Version:     gomoji.Version{Major: 11, Minor: 0},
+					CodePoints:  []rune{0x1F9FB},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -592,6 +607,40 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1F195",
 					Group:       "Symbols",
 					SubGroup:    "alphanum",
+					Name:        "NEW button",
+					Version:     gomoji.Version{Major: 0, Minor: 6},
+					CodePoints:  []rune{0x1F195},
+					Status:      gomoji.StatusFullyQualified,
+				},
+			},
+		},
+		{
+			name:     "repeating emojis keep the first occurrence order",
+			inputStr: "🧻 🦋 🧻 🦋",
+			want: []gomoji.Emoji{
+				{
+					Slug:        "roll-of-paper",
+					Character:   "🧻",
+					UnicodeName: "E11.0 roll of paper",
+					CodePoint:   "1F9FB",
+					Group:       "Objects",
+					SubGroup:    "household",
+					Name:        "roll of paper",
+					Version:     gomoji.Version{Major: 11, Minor: 0},
+					CodePoints:  []rune{0x1F9FB},
+					Status:      gomoji.StatusFullyQualified,
+				},
+				{
+					Slug:        "butterfly",
+					Character:   "🦋",
+					UnicodeName: "E3.0 butterfly",
+					CodePoint:   "1F98B",
+					Group:       "Animals & Nature",
+					SubGroup:    "animal-bug",
+					Name:        "butterfly",
+					Version:     gomoji.Version{Major: 3, Minor: 0},
+					CodePoints:  []rune{0x1F98B},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -606,6 +655,10 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1F426 200D 1F525",
 					Group:       "Animals & Nature",
 					SubGroup:    "animal-bird",
+					Name:        "phoenix",
+					Version:     gomoji.Version{Major: 15, Minor: 1},
+					CodePoints:  []rune{0x1F426, 0x200D, 0x1F525},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "face-with-bags-under-eyes",
@@ -614,6 +667,10 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1FAE9",
 					Group:       "Smileys & Emotion",
 					SubGroup:    "face-sleepy",
+					Name:        "face with bags under eyes",
+					Version:     gomoji.Version{Major: 16, Minor: 0},
+					CodePoints:  []rune{0x1FAE9},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "fingerprint",
@@ -622,6 +679,10 @@ func TestFindAll(t *testing.T) {
 					CodePoint:   "1FAC6",
 					Group:       "People & Body",
 					SubGroup:    "person-symbol",
+					Name:        "fingerprint",
+					Version:     gomoji.Version{Major: 16, Minor: 0},
+					CodePoints:  []rune{0x1FAC6},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -630,13 +691,6 @@ func TestFindAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			got := gomoji.FindAll(tt.inputStr)
 
-			sort.Slice(tt.want, func(i, j int) bool {
-				return tt.want[i].Character < tt.want[j].Character
-			})
-			sort.Slice(got, func(i, j int) bool {
-				return got[i].Character < got[j].Character
-			})
-
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("FindAll() = %v, want %v", got, tt.want)
 			}
@@ -685,6 +739,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F98B",
 					Group:       "Animals & Nature",
 					SubGroup:    "animal-bug",
+					Name:        "butterfly",
+					Version:     gomoji.Version{Major: 3, Minor: 0},
+					CodePoints:  []rune{0x1F98B},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "roll-of-paper",
@@ -693,6 +751,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F9FB",
 					Group:       "Objects",
 					SubGroup:    "household",
+					Name:        "roll of paper",
+					Version:     gomoji.Version{Major: 11, Minor: 0},
+					CodePoints:  []rune{0x1F9FB},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -707,6 +769,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F195",
 					Group:       "Symbols",
 					SubGroup:    "alphanum",
+					Name:        "NEW button",
+					Version:     gomoji.Version{Major: 0, Minor: 6},
+					CodePoints:  []rune{0x1F195},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -721,6 +787,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F195",
 					Group:       "Symbols",
 					SubGroup:    "alphanum",
+					Name:        "NEW button",
+					Version:     gomoji.Version{Major: 0, Minor: 6},
+					CodePoints:  []rune{0x1F195},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "roll-of-paper",
@@ -729,6 +799,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F9FB",
 					Group:       "Objects",
 					SubGroup:    "household",
+					Name:        "roll of paper",
+					Version:     gomoji.Version{Major: 11, Minor: 0},
+					CodePoints:  []rune{0x1F9FB},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "butterfly",
@@ -737,6 +811,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F98B",
 					Group:       "Animals & Nature",
 					SubGroup:    "animal-bug",
+					Name:        "butterfly",
+					Version:     gomoji.Version{Major: 3, Minor: 0},
+					CodePoints:  []rune{0x1F98B},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "roll-of-paper",
@@ -745,6 +823,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F9FB",
 					Group:       "Objects",
 					SubGroup:    "household",
+					Name:        "roll of paper",
+					Version:     gomoji.Version{Major: 11, Minor: 0},
+					CodePoints:  []rune{0x1F9FB},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "butterfly",
@@ -753,6 +835,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F98B",
 					Group:       "Animals & Nature",
 					SubGroup:    "animal-bug",
+					Name:        "butterfly",
+					Version:     gomoji.Version{Major: 3, Minor: 0},
+					CodePoints:  []rune{0x1F98B},
+					Status:      gomoji.StatusFullyQualified,
 				},
 				{
 					Slug:        "new-button",
@@ -761,6 +847,10 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F195",
 					Group:       "Symbols",
 					SubGroup:    "alphanum",
+					Name:        "NEW button",
+					Version:     gomoji.Version{Major: 0, Minor: 6},
+					CodePoints:  []rune{0x1F195},
+					Status:      gomoji.StatusFullyQualified,
 				},
 			},
 		},
@@ -775,6 +865,8 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F1E6",
 					Group:       "symbols",
 					SubGroup:    "symbols",
+					Name:        "regional indicator symbol letter A",
+					CodePoints:  []rune{0x1F1E6},
 				},
 				{
 					Slug:        "regional-indicator-symbol-letter-B",
@@ -783,6 +875,8 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F1E7",
 					Group:       "symbols",
 					SubGroup:    "symbols",
+					Name:        "regional indicator symbol letter B",
+					CodePoints:  []rune{0x1F1E7},
 				},
 				{
 					Slug:        "regional-indicator-symbol-letter-C",
@@ -791,6 +885,8 @@ func TestCollectAll(t *testing.T) {
 					CodePoint:   "1F1E8",
 					Group:       "symbols",
 					SubGroup:    "symbols",
+					Name:        "regional indicator symbol letter C",
+					CodePoints:  []rune{0x1F1E8},
 				},
 			},
 		},
@@ -803,3 +899,56 @@ func TestCollectAll(t *testing.T) {
 		})
 	}
 }
+
+func TestAllEmojisOrder(t *testing.T) {
+	emojis := gomoji.AllEmojis()
+
+	if got := gomoji.AllEmojis(); !reflect.DeepEqual(got, emojis) {
+		t.Fatal("AllEmojis() order differs between calls")
+	}
+
+	// Inside a subgroup, emojis follow emoji-test.txt rather than code points.
+	var first []string
+	for _, em := range emojis[:8] {
+		first = append(first, em.Character)
+	}
+	if want := []string{"😀", "😃", "😄", "😁", "😆", "😅", "🤣", "😂"}; !reflect.DeepEqual(first, want) {
+		t.Errorf("first emojis = %q, want %q", first, want)
+	}
+
+	groups := []string{
+		"Smileys & Emotion", "People & Body", "Component", "Animals & Nature", "Food & Drink",
+		"Travel & Places", "Activities", "Objects", "Symbols", "Flags",
+	}
+	var got []string
+	for _, em := range emojis {
+		if em.Status == gomoji.StatusUnknown {
+			break
+		}
+		if len(got) == 0 || got[len(got)-1] != em.Group {
+			got = append(got, em.Group)
+		}
+	}
+	if !reflect.DeepEqual(got, groups) {
+		t.Errorf("groups = %v, want %v", got, groups)
+	}
+
+	var hearts []string
+	for _, em := range emojis {
+		if em.SubGroup == "heart" && em.Status == gomoji.StatusFullyQualified && len(hearts) < 4 {
+			hearts = append(hearts, em.Character)
+		}
+	}
+	if want := []string{"💌", "💘", "💝", "💖"}; !reflect.DeepEqual(hearts, want) {
+		t.Errorf("first hearts = %q, want %q", hearts, want)
+	}
+
+	// Spellings of an emoji are adjacent, preferred one first.
+	for i, em := range emojis {
+		if em.Character == "#\u20e3" {
+			if prev := emojis[i-1].Character; prev != "#\ufe0f\u20e3" {
+				t.Errorf("spelling before %q = %q, want %q", em.Character, prev, "#\ufe0f\u20e3")
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package gomoji
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// toneFamily is a fully-qualified emoji without skin tones together with its toned fully-qualified variants.
+type toneFamily struct {
+	base  Emoji
+	toned []Emoji
+}
+
+// decodeJSON decodes the JSON document of a third-party dataset, wrapping errors into gomoji.ErrInvalidDataset.
+func decodeJSON(r io.Reader, v interface{}) error {
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+	}
+
+	return nil
+}
+
+// encodeJSON writes v as an indented JSON document.
+func encodeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// toneFamilies groups the fully-qualified emojis of the dataset by their spelling without skin tones, in the
+// order of the dataset. Toned emojis whose toneless spelling is not in the dataset, such as 🧑🏻‍❤️‍🧑🏿,
+// join the family of the emoji WithoutSkinTone returns.
+func (d *Dataset) toneFamilies() []*toneFamily {
+	var families []*toneFamily
+	byKey := make(map[string]*toneFamily)
+	for _, em := range d.emojis {
+		if em.Status != StatusFullyQualified || strings.IndexFunc(em.Character, isSkinToneModifier) >= 0 {
+			continue
+		}
+
+		f := &toneFamily{base: em}
+		families = append(families, f)
+		byKey[toneKey(em.Character)] = f
+	}
+
+	for _, em := range d.emojis {
+		if em.Status != StatusFullyQualified || strings.IndexFunc(em.Character, isSkinToneModifier) < 0 {
+			continue
+		}
+
+		f, ok := byKey[toneKey(em.Character)]
+		if !ok {
+			f, ok = byKey[toneKey(WithoutSkinTone(em).Character)]
+		}
+		if ok {
+			f.toned = append(f.toned, em)
+		}
+	}
+
+	return families
+}
+
+// spellings returns the set of the spellings in the dataset.
+func (d *Dataset) spellings() map[string]bool {
+	spellings := make(map[string]bool, len(d.emojis))
+	for _, em := range d.emojis {
+		spellings[em.Character] = true
+	}
+
+	return spellings
+}
+
+// unqualifiedSpelling returns the spelling of the emoji without variation selectors if it is one of the spellings.
+func unqualifiedSpelling(em Emoji, spellings map[string]bool) (string, bool) {
+	unqualified := withoutVariationSelectors(em.Character)
+	if unqualified == em.Character || !spellings[unqualified] {
+		return "", false
+	}
+
+	return unqualified, true
+}
+
+// shortcodes returns the imported shortcodes of the emoji, or the aliases of the set if there are none.
+func (d *Dataset) shortcodes(em Emoji, set *ShortcodeSet) []string {
+	if md, ok := d.Metadata(em); ok && len(md.Shortcodes) > 0 {
+		return md.Shortcodes
+	}
+
+	return set.Aliases(em)
+}
+
+// newImportedEmoji returns an emoji of a third-party dataset with the fields derived from the spelling and name.
+func newImportedEmoji(runes []rune, name string, version Version, status Status) Emoji {
+	return Emoji{
+		Slug:        nameToSlug(name),
+		Character:   string(runes),
+		UnicodeName: "E" + version.String() + " " + name,
+		CodePoint:   codePointNotation(runes),
+		Name:        name,
+		Version:     version,
+		CodePoints:  runes,
+		Status:      status,
+	}
+}
+
+// tonedName returns the CLDR name of the toned variant of an emoji, e.g. "waving hand: light skin tone".
+func tonedName(name string, tones []SkinTone) string {
+	names := make([]string, 0, len(tones))
+	for _, tone := range tones {
+		names = append(names, tone.String())
+	}
+
+	sep := ": "
+	if strings.Contains(name, ": ") {
+		sep = ", "
+	}
+
+	return name + sep + strings.Join(names, ", ")
+}
+
+// codePointNotation formats the runes like the CodePoint field, e.g. "0023 FE0F 20E3".
+func codePointNotation(runes []rune) string {
+	return strings.ReplaceAll(hexcode(runes, true), "-", " ")
+}
+
+// hexcode formats the runes as upper-case hyphen-separated code points, e.g. "1F44B-1F3FB".
+func hexcode(runes []rune, withVariationSelectors bool) string {
+	codes := make([]string, 0, len(runes))
+	for _, r := range runes {
+		if isVariationSelector(r) && !withVariationSelectors {
+			continue
+		}
+		codes = append(codes, fmt.Sprintf("%04X", r))
+	}
+
+	return strings.Join(codes, "-")
+}
+
+// toneKey returns the spelling without skin tone modifiers and variation selectors.
+func toneKey(s string) string {
+	return withoutVariationSelectors(strings.Map(dropSkinToneModifier, s))
+}
@@ -0,0 +1,54 @@
+package gomoji
+
+import "strings"
+
+const combiningEnclosingKeycap = '\u20E3'
+
+// Keycap returns the keycap emoji of the r rune, which is one of the digits 0 to 9, '#' or '*', e.g. 1️⃣ for '1'.
+// The keycap for 10 is 🔟, which has no single rune and can be found by the "keycap-10" slug. If there is no such
+// keycap, it returns the gomoji.ErrEmojiNotFound error.
+func Keycap(r rune) (Emoji, error) {
+	if (r < '0' || r > '9') && r != '#' && r != '*' {
+		return Emoji{}, ErrEmojiNotFound
+	}
+
+	em, ok := defaultMatcher.lookup(string([]rune{r, emojiPresentationSelector, combiningEnclosingKeycap}))
+	if !ok {
+		return Emoji{}, ErrEmojiNotFound
+	}
+
+	return em, nil
+}
+
+// KeycapValue returns the ASCII text of the keycap emoji, e.g. "1" for 1️⃣ and 1⃣, "#" for #️⃣ and "10" for 🔟.
+// It reports false if the emoji is not a keycap.
+func KeycapValue(e Emoji) (string, bool) {
+	if e.SubGroup != "keycap" {
+		return "", false
+	}
+
+	if !strings.HasPrefix(e.Slug, "keycap-") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(e.Slug, "keycap-"), true
+}
+
+// ReplaceKeycapsWithASCII replaces the keycap emojis in the s string with their ASCII text and returns a new string,
+// e.g. "rank 1" for "rank 1️⃣". The rest of the string, including other emojis, is kept byte for byte.
+func ReplaceKeycapsWithASCII(s string) string {
+	return defaultMatcher.replaceMatches(s, func(match Match) (string, bool) {
+		return KeycapValue(match.Emoji)
+	})
+}
+
+// KeycapToASCII is a replacer for ReplaceEmojisWithFunc that converts keycaps to their ASCII text, e.g. "1" for 1️⃣.
+// Other emojis are returned in their canonical spelling, but ReplaceEmojisWithFunc strips variation selectors from
+// its whole result. Use ReplaceKeycapsWithASCII to leave everything but the keycaps untouched.
+func KeycapToASCII(e Emoji) string {
+	if value, ok := KeycapValue(e); ok {
+		return value
+	}
+
+	return e.Character
+}
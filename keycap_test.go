@@ -0,0 +1,156 @@
+package gomoji_test
+
+import (
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestKeycap(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       rune
+		want    string
+		wantErr error
+	}{
+		{
+			name: "digit",
+			r:    '1',
+			want: "1️⃣",
+		},
+		{
+			name: "number sign",
+			r:    '#',
+			want: "#️⃣",
+		},
+		{
+			name: "asterisk",
+			r:    '*',
+			want: "*️⃣",
+		},
+		{
+			name:    "letter",
+			r:       'a',
+			wantErr: gomoji.ErrEmojiNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.Keycap(tt.r)
+			if err != tt.wantErr {
+				t.Fatalf("Keycap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Character != tt.want {
+				t.Errorf("Keycap() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeycapValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		emoji  string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "fully qualified",
+			emoji:  "0️⃣",
+			want:   "0",
+			wantOk: true,
+		},
+		{
+			name:   "unqualified",
+			emoji:  "0⃣",
+			want:   "0",
+			wantOk: true,
+		},
+		{
+			name:   "asterisk",
+			emoji:  "*️⃣",
+			want:   "*",
+			wantOk: true,
+		},
+		{
+			name:   "ten",
+			emoji:  "🔟",
+			want:   "10",
+			wantOk: true,
+		},
+		{
+			name:   "not a keycap",
+			emoji:  "🔢",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			got, ok := gomoji.KeycapValue(em)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("KeycapValue() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestKeycapToASCII(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "ranking",
+			inputStr: "1️⃣ Alice 2⃣ Bob 🔟 Eve",
+			want:     "1 Alice 2 Bob 10 Eve",
+		},
+		{
+			name:     "other emojis are kept",
+			inputStr: "#️⃣ winner 🏆",
+			want:     "# winner 🏆",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.ReplaceEmojisWithFunc(tt.inputStr, gomoji.KeycapToASCII); got != tt.want {
+				t.Errorf("ReplaceEmojisWithFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceKeycapsWithASCII(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "ranking",
+			inputStr: "1️⃣ Alice 2⃣ Bob 🔟 Eve",
+			want:     "1 Alice 2 Bob 10 Eve",
+		},
+		{
+			name:     "other emojis are kept byte for byte",
+			inputStr: "rank 1️⃣ 🏳️‍🌈 ❤️ ☺️",
+			want:     "rank 1 🏳️‍🌈 ❤️ ☺️",
+		},
+		{
+			name:     "string without keycaps",
+			inputStr: "#1 winner 🏆",
+			want:     "#1 winner 🏆",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.ReplaceKeycapsWithASCII(tt.inputStr); got != tt.want {
+				t.Errorf("ReplaceKeycapsWithASCII() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package gomoji
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// inheritanceMarker is the CLDR value that stands for the value of the parent locale.
+const inheritanceMarker = "↑↑↑"
+
+// Annotations are the localized names and keywords of emojis in one locale, as published by CLDR
+// in common/annotations/<locale>.xml and common/annotationsDerived/<locale>.xml.
+type Annotations struct {
+	// Lang is the CLDR locale identifier, e.g. "de" or "pt_PT".
+	Lang string
+
+	names    map[string]string
+	keywords map[string][]string
+}
+
+var locales = struct {
+	sync.RWMutex
+	byLang map[string]*Annotations
+}{byLang: make(map[string]*Annotations)}
+
+type cldrDocument struct {
+	Identity struct {
+		Language  cldrType `xml:"language"`
+		Script    cldrType `xml:"script"`
+		Territory cldrType `xml:"territory"`
+	} `xml:"identity"`
+	Annotations []struct {
+		CP    string `xml:"cp,attr"`
+		Type  string `xml:"type,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"annotations>annotation"`
+}
+
+type cldrType struct {
+	Type string `xml:"type,attr"`
+}
+
+// LoadAnnotations parses a CLDR annotations XML file, such as common/annotations/de.xml or
+// common/annotationsDerived/de.xml. The locale is taken from the identity element of the file.
+// If the file is malformed, it returns an error wrapping the gomoji.ErrInvalidAnnotations error.
+func LoadAnnotations(r io.Reader) (*Annotations, error) {
+	var doc cldrDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAnnotations, err)
+	}
+	if doc.Identity.Language.Type == "" {
+		return nil, fmt.Errorf("%w: the locale is missing", ErrInvalidAnnotations)
+	}
+
+	lang := doc.Identity.Language.Type
+	for _, subtag := range []string{doc.Identity.Script.Type, doc.Identity.Territory.Type} {
+		if subtag != "" {
+			lang += "_" + subtag
+		}
+	}
+
+	a := &Annotations{
+		Lang:     lang,
+		names:    make(map[string]string),
+		keywords: make(map[string][]string),
+	}
+	for _, an := range doc.Annotations {
+		value := strings.TrimSpace(an.Value)
+		if an.CP == "" || value == "" || value == inheritanceMarker {
+			continue
+		}
+
+		key := withoutVariationSelectors(an.CP)
+		if an.Type == "tts" {
+			a.names[key] = value
+			continue
+		}
+		for _, k := range strings.Split(value, "|") {
+			if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+				a.keywords[key] = appendKeyword(a.keywords[key], k)
+			}
+		}
+	}
+
+	return a, nil
+}
+
+// Name returns the localized name of the emoji, in any spelling. It reports false if the emoji is not annotated.
+func (a *Annotations) Name(e Emoji) (string, bool) {
+	name, ok := a.names[withoutVariationSelectors(e.Character)]
+	return name, ok
+}
+
+// Keywords returns the localized keywords of the emoji, in any spelling. If the emoji has no keywords
+// it returns a nil-slice.
+func (a *Annotations) Keywords(e Emoji) []string {
+	return append([]string(nil), a.keywords[withoutVariationSelectors(e.Character)]...)
+}
+
+// RegisterAnnotations makes the annotations available to NameIn, SearchIn and ReplaceEmojisWithName under
+// their locale. Annotations registered for the same locale are merged, the later ones taking precedence,
+// so that the annotations and annotationsDerived files of a locale can be registered one after another.
+// It is safe for concurrent use.
+func RegisterAnnotations(a *Annotations) {
+	lang := normalizeLang(a.Lang)
+
+	locales.Lock()
+	defer locales.Unlock()
+
+	merged := &Annotations{
+		Lang:     a.Lang,
+		names:    make(map[string]string),
+		keywords: make(map[string][]string),
+	}
+	for _, src := range []*Annotations{locales.byLang[lang], a} {
+		if src == nil {
+			continue
+		}
+		for key, name := range src.names {
+			merged.names[key] = name
+		}
+		for key, keywords := range src.keywords {
+			merged.keywords[key] = keywords
+		}
+	}
+
+	locales.byLang[lang] = merged
+}
+
+// NameIn returns the name of the emoji in the lang locale, e.g. "grinsendes Gesicht" for 😀 in "de".
+// The locale is case-insensitive and may use a hyphen, e.g. "de-CH". If the locale has no name for the emoji,
+// the name falls back to the language without region, e.g. "de", and then to the English name.
+func NameIn(e Emoji, lang string) string {
+	for _, a := range annotationsFor(lang) {
+		if name, ok := a.Name(e); ok {
+			return name
+		}
+	}
+
+	return e.Name
+}
+
+// SearchIn finds the emojis matching the query by their names and keywords in the lang locale, falling back
+// like NameIn. The ranking and the limit work like in Search.
+func SearchIn(lang, query string, limit int) []Emoji {
+	chain := annotationsFor(lang)
+	if len(chain) == 0 {
+		return Search(query, limit)
+	}
+
+	base := defaultMatcher.searches.get(defaultMatcher).entries
+	entries := make([]searchEntry, 0, len(base))
+	for _, entry := range base {
+		localized := searchEntry{emoji: entry.emoji, name: entry.name, keywords: entry.keywords}
+		for _, a := range chain {
+			if name, ok := a.Name(entry.emoji); ok {
+				localized.name = strings.ToLower(name)
+				localized.keywords = a.Keywords(entry.emoji)
+				break
+			}
+		}
+		entries = append(entries, localized)
+	}
+
+	return searchEntries(entries, query, limit)
+}
+
+// ReplaceEmojisWithName replaces all emojis in the s string with their names in the lang locale
+// and returns a new string, e.g. "hello grinsendes Gesicht" for "hello 😀" in "de". See NameIn.
+func ReplaceEmojisWithName(s, lang string) string {
+	chain := annotationsFor(lang)
+
+	return ReplaceEmojisWithFunc(s, func(em Emoji) string {
+		for _, a := range chain {
+			if name, ok := a.Name(em); ok {
+				return name
+			}
+		}
+		return em.Name
+	})
+}
+
+// annotationsFor returns the registered annotations of the locale followed by the ones of its language.
+func annotationsFor(lang string) []*Annotations {
+	lang = normalizeLang(lang)
+
+	locales.RLock()
+	defer locales.RUnlock()
+
+	var chain []*Annotations
+	for {
+		if a, ok := locales.byLang[lang]; ok {
+			chain = append(chain, a)
+		}
+
+		i := strings.LastIndexByte(lang, '_')
+		if i < 0 {
+			return chain
+		}
+		lang = lang[:i]
+	}
+}
+
+// normalizeLang turns a locale identifier into the lower-case CLDR form, e.g. "de-CH" into "de_ch".
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(lang, "-", "_"))
+}
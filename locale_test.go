@@ -0,0 +1,211 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+const germanAnnotations = `<?xml version="1.0" encoding="UTF-8" ?>
+<ldml>
+	<identity>
+		<version number="$Revision$"/>
+		<language type="de"/>
+	</identity>
+	<annotations>
+		<annotation cp="😀">Gesicht | grinsendes Gesicht | lol | lustig</annotation>
+		<annotation cp="😀" type="tts">grinsendes Gesicht</annotation>
+		<annotation cp="❤">Herz | Liebe</annotation>
+		<annotation cp="❤" type="tts">rotes Herz</annotation>
+		<annotation cp="🦋">↑↑↑</annotation>
+	</annotations>
+</ldml>`
+
+const swissGermanAnnotations = `<ldml>
+	<identity>
+		<language type="de"/>
+		<territory type="CH"/>
+	</identity>
+	<annotations>
+		<annotation cp="❤" type="tts">rotes Härz</annotation>
+	</annotations>
+</ldml>`
+
+func registerTestAnnotations(t *testing.T) {
+	t.Helper()
+
+	for _, doc := range []string{germanAnnotations, swissGermanAnnotations} {
+		a, err := gomoji.LoadAnnotations(strings.NewReader(doc))
+		if err != nil {
+			t.Fatalf("LoadAnnotations() error = %v", err)
+		}
+		gomoji.RegisterAnnotations(a)
+	}
+}
+
+func TestLoadAnnotations(t *testing.T) {
+	a, err := gomoji.LoadAnnotations(strings.NewReader(swissGermanAnnotations))
+	if err != nil {
+		t.Fatalf("LoadAnnotations() error = %v", err)
+	}
+	if a.Lang != "de_CH" {
+		t.Errorf("Lang = %q, want %q", a.Lang, "de_CH")
+	}
+
+	a, err = gomoji.LoadAnnotations(strings.NewReader(germanAnnotations))
+	if err != nil {
+		t.Fatalf("LoadAnnotations() error = %v", err)
+	}
+	heart, _ := gomoji.GetInfo("❤️")
+	if name, ok := a.Name(heart); name != "rotes Herz" || !ok {
+		t.Errorf("Name() = %q, %v, want %q, true", name, ok, "rotes Herz")
+	}
+	if got, want := a.Keywords(heart), []string{"herz", "liebe"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keywords() = %q, want %q", got, want)
+	}
+	butterfly, _ := gomoji.GetInfo("🦋")
+	if got := a.Keywords(butterfly); got != nil {
+		t.Errorf("Keywords() of an inherited value = %q, want nil", got)
+	}
+}
+
+func TestLoadAnnotationsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{
+			name: "malformed xml",
+			doc:  "<ldml><annotations>",
+		},
+		{
+			name: "missing locale",
+			doc:  "<ldml><annotations></annotations></ldml>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := gomoji.LoadAnnotations(strings.NewReader(tt.doc)); !errors.Is(err, gomoji.ErrInvalidAnnotations) {
+				t.Errorf("LoadAnnotations() error = %v, want %v", err, gomoji.ErrInvalidAnnotations)
+			}
+		})
+	}
+}
+
+func TestNameIn(t *testing.T) {
+	registerTestAnnotations(t)
+
+	tests := []struct {
+		name  string
+		emoji string
+		lang  string
+		want  string
+	}{
+		{
+			name:  "localized",
+			emoji: "😀",
+			lang:  "de",
+			want:  "grinsendes Gesicht",
+		},
+		{
+			name:  "any spelling",
+			emoji: "❤",
+			lang:  "DE",
+			want:  "rotes Herz",
+		},
+		{
+			name:  "region",
+			emoji: "❤️",
+			lang:  "de-CH",
+			want:  "rotes Härz",
+		},
+		{
+			name:  "falls back to the language",
+			emoji: "😀",
+			lang:  "de_CH",
+			want:  "grinsendes Gesicht",
+		},
+		{
+			name:  "falls back to English",
+			emoji: "🦋",
+			lang:  "de",
+			want:  "butterfly",
+		},
+		{
+			name:  "unknown locale",
+			emoji: "😀",
+			lang:  "xx",
+			want:  "grinning face",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if got := gomoji.NameIn(em, tt.lang); got != tt.want {
+				t.Errorf("NameIn() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchIn(t *testing.T) {
+	registerTestAnnotations(t)
+
+	tests := []struct {
+		name  string
+		lang  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "localized name",
+			lang:  "de",
+			query: "rotes herz",
+			want:  []string{"❤️"},
+		},
+		{
+			name:  "localized keyword",
+			lang:  "de",
+			query: "lustig",
+			want:  []string{"😀"},
+		},
+		{
+			name:  "English names of emojis without annotations",
+			lang:  "de",
+			query: "butterfly",
+			want:  []string{"🦋"},
+		},
+		{
+			name:  "unknown locale",
+			lang:  "xx",
+			query: "pizza",
+			want:  []string{"🍕"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, em := range gomoji.SearchIn(tt.lang, tt.query, 0) {
+				got = append(got, em.Character)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchIn() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceEmojisWithName(t *testing.T) {
+	registerTestAnnotations(t)
+
+	got := gomoji.ReplaceEmojisWithName("hallo 😀 ❤ 🦋", "de")
+	if want := "hallo grinsendes Gesicht rotes Herz butterfly"; got != want {
+		t.Errorf("ReplaceEmojisWithName() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package gomoji
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// lookupTable indexes an emoji list by slug, code points and name.
+type lookupTable struct {
+	once        sync.Once
+	bySlug      map[string][]Emoji
+	byCodePoint map[string][]Emoji
+	byName      map[string][]Emoji
+}
+
+// GetBySlug returns all spellings of the emoji with the given slug, preferred one first.
+// If there is no such emoji, it returns the gomoji.ErrEmojiNotFound error.
+func GetBySlug(slug string) ([]Emoji, error) {
+	return defaultMatcher.GetBySlug(slug)
+}
+
+// GetByCodePoint returns all spellings of the emoji with the given code points, preferred one first.
+// The code points are hexadecimal, optionally prefixed with "U+" and separated by spaces or hyphens,
+// e.g. "2764", "U+2764", "1F3C3 200D 2640" or "2764-200D-1F525". Variation selectors are ignored.
+// If the code points are malformed, it returns the gomoji.ErrInvalidCodePoint error;
+// if there is no such emoji, it returns the gomoji.ErrEmojiNotFound error.
+func GetByCodePoint(codePoint string) ([]Emoji, error) {
+	return defaultMatcher.GetByCodePoint(codePoint)
+}
+
+// GetByName returns all spellings of the emoji with the given Unicode name, preferred one first.
+// The name is matched case-insensitively and without the emoji version prefix, e.g. "Red Heart".
+// If there is no such emoji, it returns the gomoji.ErrEmojiNotFound error.
+func GetByName(name string) ([]Emoji, error) {
+	return defaultMatcher.GetByName(name)
+}
+
+// GetBySlug returns all spellings of the emoji with the given slug, preferred one first.
+// See the package-level GetBySlug.
+func (m *Matcher) GetBySlug(slug string) ([]Emoji, error) {
+	t := m.lookups.get(m.emojis)
+	return t.find(t.bySlug, slug)
+}
+
+// GetByCodePoint returns all spellings of the emoji with the given code points, preferred one first.
+// See the package-level GetByCodePoint.
+func (m *Matcher) GetByCodePoint(codePoint string) ([]Emoji, error) {
+	runes, err := parseCodePoints(codePoint)
+	if err != nil {
+		return nil, err
+	}
+
+	t := m.lookups.get(m.emojis)
+	return t.find(t.byCodePoint, codePointKey(runes))
+}
+
+// GetByName returns all spellings of the emoji with the given Unicode name, preferred one first.
+// See the package-level GetByName.
+func (m *Matcher) GetByName(name string) ([]Emoji, error) {
+	t := m.lookups.get(m.emojis)
+	return t.find(t.byName, nameKey(name))
+}
+
+func (t *lookupTable) get(emojis map[string]Emoji) *lookupTable {
+	t.once.Do(func() {
+		t.build(emojis)
+	})
+	return t
+}
+
+func (t *lookupTable) find(index map[string][]Emoji, key string) ([]Emoji, error) {
+	emojis, ok := index[key]
+	if !ok {
+		return nil, ErrEmojiNotFound
+	}
+
+	return append([]Emoji(nil), emojis...), nil
+}
+
+func (t *lookupTable) build(emojis map[string]Emoji) {
+	t.bySlug = make(map[string][]Emoji)
+	t.byCodePoint = make(map[string][]Emoji)
+	t.byName = make(map[string][]Emoji)
+
+	characters := make([]string, 0, len(emojis))
+	for character := range emojis {
+		characters = append(characters, character)
+	}
+	sort.Slice(characters, func(i, j int) bool {
+		return preferSpelling(emojis, characters[i], characters[j])
+	})
+
+	for _, character := range characters {
+		em := emojis[character]
+
+		t.bySlug[em.Slug] = append(t.bySlug[em.Slug], em)
+
+		cpKey := codePointKey([]rune(character))
+		t.byCodePoint[cpKey] = append(t.byCodePoint[cpKey], em)
+
+		nKey := nameKey(em.Name)
+		t.byName[nKey] = append(t.byName[nKey], em)
+	}
+}
+
+// parseCodePoints parses code point notation such as "U+1F3C3 200D 2640" or "2764-200D-1F525".
+func parseCodePoints(s string) ([]rune, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-'
+	})
+	if len(fields) == 0 {
+		return nil, ErrInvalidCodePoint
+	}
+
+	runes := make([]rune, 0, len(fields))
+	for _, field := range fields {
+		if len(field) > 2 && (field[:2] == "U+" || field[:2] == "u+") {
+			field = field[2:]
+		}
+
+		cp, err := strconv.ParseUint(field, 16, 32)
+		if err != nil || !utf8.ValidRune(rune(cp)) {
+			return nil, ErrInvalidCodePoint
+		}
+		runes = append(runes, rune(cp))
+	}
+
+	return runes, nil
+}
+
+// codePointKey formats the runes as upper-case space-separated hexadecimal code points, skipping variation selectors.
+func codePointKey(runes []rune) string {
+	var buf strings.Builder
+	for _, r := range runes {
+		if unicode.In(r, unicode.Variation_Selector) {
+			continue
+		}
+
+		if buf.Len() > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(strings.ToUpper(strconv.FormatInt(int64(r), 16)))
+	}
+
+	return buf.String()
+}
+
+// nameKey lower-cases the name and strips the emoji version prefix, e.g. "E3.0 butterfly" becomes "butterfly".
+func nameKey(name string) string {
+	if prefix, rest, ok := strings.Cut(name, " "); ok && isVersionPrefix(prefix) {
+		name = rest
+	}
+
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// isVersionPrefix reports whether s is an emoji version such as "E15.1".
+func isVersionPrefix(s string) bool {
+	if len(s) < 2 || s[0] != 'E' {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(s[1:], 64)
+	return err == nil
+}
@@ -0,0 +1,157 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func characters(emojis []gomoji.Emoji) []string {
+	var chars []string
+	for _, em := range emojis {
+		chars = append(chars, em.Character)
+	}
+
+	return chars
+}
+
+func TestGetBySlug(t *testing.T) {
+	tests := []struct {
+		name    string
+		slug    string
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "single spelling",
+			slug: "butterfly",
+			want: []string{"🦋"},
+		},
+		{
+			name: "all spellings, fully-qualified first",
+			slug: "keycap-#",
+			want: []string{"#️⃣", "#⃣"},
+		},
+		{
+			name:    "unknown slug",
+			slug:    "not-an-emoji",
+			wantErr: gomoji.ErrEmojiNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.GetBySlug(tt.slug)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetBySlug() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(characters(got), tt.want) {
+				t.Errorf("GetBySlug() = %q, want %q", characters(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestGetByCodePoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		codePoint string
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:      "plain code point",
+			codePoint: "1F98B",
+			want:      []string{"🦋"},
+		},
+		{
+			name:      "U+ prefix matches all variation selector spellings",
+			codePoint: "U+2764",
+			want:      []string{"❤️", "❤"},
+		},
+		{
+			name:      "space-separated sequence",
+			codePoint: "1F3C3 200D 2640",
+			want:      []string{"🏃‍♀️", "🏃‍♀"},
+		},
+		{
+			name:      "hyphenated lower-case sequence",
+			codePoint: "2764-fe0f-200d-1f525",
+			want:      []string{"❤️‍🔥", "❤‍🔥"},
+		},
+		{
+			name:      "malformed code point",
+			codePoint: "U+ZZZZ",
+			wantErr:   gomoji.ErrInvalidCodePoint,
+		},
+		{
+			name:      "empty code point",
+			codePoint: " ",
+			wantErr:   gomoji.ErrInvalidCodePoint,
+		},
+		{
+			name:      "not an emoji",
+			codePoint: "0041",
+			wantErr:   gomoji.ErrEmojiNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.GetByCodePoint(tt.codePoint)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetByCodePoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(characters(got), tt.want) {
+				t.Errorf("GetByCodePoint() = %q, want %q", characters(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestGetByName(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputName string
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:      "lower-case name",
+			inputName: "butterfly",
+			want:      []string{"🦋"},
+		},
+		{
+			name:      "mixed-case name",
+			inputName: "New Button",
+			want:      []string{"🆕"},
+		},
+		{
+			name:      "name with emoji version",
+			inputName: "E0.6 red heart",
+			want:      []string{"❤️", "❤"},
+		},
+		{
+			name:      "unknown name",
+			inputName: "not an emoji",
+			wantErr:   gomoji.ErrEmojiNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.GetByName(tt.inputName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetByName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(characters(got), tt.want) {
+				t.Errorf("GetByName() = %q, want %q", characters(got), tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkGetBySlug(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gomoji.GetBySlug("butterfly") // nolint:errcheck
+	}
+}
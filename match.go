@@ -0,0 +1,119 @@
+package gomoji
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Match describes a single emoji occurrence inside a string.
+//
+// All End offsets are exclusive, so s[m.Start:m.End] == m.Str for the string s the match was found in.
+type Match struct {
+	// Emoji is the dataset entry the matched text resolved to.
+	Emoji Emoji
+	// Str is the exact matched substring, including any trailing variation selectors.
+	Str string
+	// Start and End are byte offsets.
+	Start, End int
+	// RuneStart and RuneEnd are rune (code point) offsets.
+	RuneStart, RuneEnd int
+	// Grapheme is the index of the grapheme cluster containing the match.
+	Grapheme int
+}
+
+// Matches finds all emojis in given string together with their positions. Like CollectAll, it does not
+// distinct repeating occurrences of emoji and keeps them in order of appearance. If there are no emojis
+// it returns a nil-slice.
+func Matches(s string) []Match {
+	return defaultMatcher.Matches(s)
+}
+
+// Matches finds all emojis in given string together with their positions. See the package-level Matches.
+func (m *Matcher) Matches(s string) []Match {
+	var matches []Match
+
+	var runeOffset, grapheme int
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		start, _ := gr.Positions()
+		matches = m.appendClusterMatches(matches, gr.Str(), start, runeOffset, grapheme)
+
+		runeOffset += len(gr.Runes())
+		grapheme++
+	}
+
+	return matches
+}
+
+// appendClusterMatches appends the emojis found in a single grapheme cluster to the matches.
+// The cluster is looked up as a whole first. Otherwise, every rune of the cluster is looked up on its own,
+// and the variation selectors following a matched rune are attributed to that match.
+func (m *Matcher) appendClusterMatches(matches []Match, cluster string, byteOffset, runeOffset, grapheme int) []Match {
+	if em, ok := m.lookup(cluster); ok {
+		return append(matches, Match{
+			Emoji:     em,
+			Str:       cluster,
+			Start:     byteOffset,
+			End:       byteOffset + len(cluster),
+			RuneStart: runeOffset,
+			RuneEnd:   runeOffset + utf8.RuneCountInString(cluster),
+			Grapheme:  grapheme,
+		})
+	}
+
+	runeIdx := 0
+	for i := 0; i < len(cluster); {
+		_, size := utf8.DecodeRuneInString(cluster[i:])
+		end, runeEnd := i+size, runeIdx+1
+		for end < len(cluster) {
+			next, nextSize := utf8.DecodeRuneInString(cluster[end:])
+			if !isVariationSelector(next) {
+				break
+			}
+			end += nextSize
+			runeEnd++
+		}
+
+		em, ok := m.lookup(cluster[i:end])
+		if !ok {
+			i += size
+			runeIdx++
+			continue
+		}
+
+		matches = append(matches, Match{
+			Emoji:     em,
+			Str:       cluster[i:end],
+			Start:     byteOffset + i,
+			End:       byteOffset + end,
+			RuneStart: runeOffset + runeIdx,
+			RuneEnd:   runeOffset + runeEnd,
+			Grapheme:  grapheme,
+		})
+		i, runeIdx = end, runeEnd
+	}
+
+	return matches
+}
+
+// replaceMatches replaces the matches in the s string for which the replace function reports true and returns
+// a new string. The text between the matches and the matches that are not replaced are copied verbatim.
+func (m *Matcher) replaceMatches(s string, replace func(Match) (string, bool)) string {
+	var buf strings.Builder
+	var pos int
+	for _, match := range m.Matches(s) {
+		replacement, ok := replace(match)
+		if !ok {
+			continue
+		}
+
+		buf.WriteString(s[pos:match.Start])
+		buf.WriteString(replacement)
+		pos = match.End
+	}
+	buf.WriteString(s[pos:])
+
+	return buf.String()
+}
@@ -0,0 +1,96 @@
+package gomoji_test
+
+import (
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestMatches(t *testing.T) {
+	type position struct {
+		slug               string
+		str                string
+		start, end         int
+		runeStart, runeEnd int
+		grapheme           int
+	}
+
+	tests := []struct {
+		name     string
+		inputStr string
+		want     []position
+	}{
+		{
+			name:     "empty string",
+			inputStr: "",
+			want:     nil,
+		},
+		{
+			name:     "string without emoji",
+			inputStr: "hello world",
+			want:     nil,
+		},
+		{
+			name:     "string with 2 emoji",
+			inputStr: "hello 🦋 world \U0001F9FB",
+			want: []position{
+				{slug: "butterfly", str: "🦋", start: 6, end: 10, runeStart: 6, runeEnd: 7, grapheme: 6},
+				{slug: "roll-of-paper", str: "🧻", start: 17, end: 21, runeStart: 14, runeEnd: 15, grapheme: 14},
+			},
+		},
+		{
+			name:     "matched substring keeps the variation selector",
+			inputStr: "🆕️ NWT",
+			want: []position{
+				{slug: "new-button", str: "🆕️", start: 0, end: 7, runeStart: 0, runeEnd: 2, grapheme: 0},
+			},
+		},
+		{
+			name:     "zero width joiner sequence is a single match",
+			inputStr: "é 👨‍👩‍👧‍👦!",
+			want: []position{
+				{slug: "family-man,-woman,-girl,-boy", str: "👨‍👩‍👧‍👦", start: 3, end: 28, runeStart: 2, runeEnd: 9, grapheme: 2},
+			},
+		},
+		{
+			name:     "repeating emojis",
+			inputStr: "❤️❤️",
+			want: []position{
+				{slug: "red-heart", str: "❤️", start: 0, end: 6, runeStart: 0, runeEnd: 2, grapheme: 0},
+				{slug: "red-heart", str: "❤️", start: 6, end: 12, runeStart: 2, runeEnd: 4, grapheme: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gomoji.Matches(tt.inputStr)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Matches() returned %d matches, want %d: %v", len(got), len(tt.want), got)
+			}
+
+			for i, m := range got {
+				gotPos := position{
+					slug:      m.Emoji.Slug,
+					str:       m.Str,
+					start:     m.Start,
+					end:       m.End,
+					runeStart: m.RuneStart,
+					runeEnd:   m.RuneEnd,
+					grapheme:  m.Grapheme,
+				}
+				if gotPos != tt.want[i] {
+					t.Errorf("Matches()[%d] = %+v, want %+v", i, gotPos, tt.want[i])
+				}
+				if tt.inputStr[m.Start:m.End] != m.Str {
+					t.Errorf("Matches()[%d] byte offsets point to %q, want %q", i, tt.inputStr[m.Start:m.End], m.Str)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMatches(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gomoji.Matches("\U0001F96F Hi \U0001F970")
+	}
+}
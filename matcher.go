@@ -0,0 +1,310 @@
+package gomoji
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// defaultMatcher backs the package-level functions. It uses the whole local emoji list.
+var defaultMatcher = New()
+
+// Matcher detects, finds and replaces emojis of a configurable emoji list. Its methods mirror
+// the package-level functions. A Matcher is safe for concurrent use by multiple goroutines.
+type Matcher struct {
+	emojis    map[string]Emoji
+	positions map[string]int
+	strict    bool
+
+	canonicals canonicalTable
+	lookups    lookupTable
+	order      orderTable
+	searches   searchTable
+
+	presentations presentationTable
+}
+
+// Option configures a Matcher.
+type Option func(*matcherOptions)
+
+type matcherOptions struct {
+	groups               map[string]bool
+	excludedGroups       map[string]bool
+	noComponents         bool
+	noTextPresentation   bool
+	noRegionalIndicators bool
+	strict               bool
+	versions             VersionRange
+	custom               []Emoji
+	dataset              *Dataset
+}
+
+// WithGroups limits the Matcher to the emojis of the given groups, e.g. "Smileys & Emotion".
+func WithGroups(groups ...string) Option {
+	return func(o *matcherOptions) {
+		if o.groups == nil {
+			o.groups = make(map[string]bool)
+		}
+		for _, group := range groups {
+			o.groups[group] = true
+		}
+	}
+}
+
+// WithoutGroups excludes the emojis of the given groups from the Matcher.
+func WithoutGroups(groups ...string) Option {
+	return func(o *matcherOptions) {
+		if o.excludedGroups == nil {
+			o.excludedGroups = make(map[string]bool)
+		}
+		for _, group := range groups {
+			o.excludedGroups[group] = true
+		}
+	}
+}
+
+// WithoutComponents excludes components, such as hair styles, that are not meant to be used on their own.
+func WithoutComponents() Option {
+	return func(o *matcherOptions) {
+		o.noComponents = true
+	}
+}
+
+// WithoutTextPresentation excludes symbols that render as text by default and need U+FE0F to render
+// as emoji, such as ©, ®, ™ and ‼, in all their spellings.
+func WithoutTextPresentation() Option {
+	return func(o *matcherOptions) {
+		o.noTextPresentation = true
+	}
+}
+
+// WithoutRegionalIndicators excludes lone regional indicator letters such as 🇦. Following UAX #29, a run of
+// regional indicators is split into pairs from its start, regardless of whether a pair forms a flag: 🇦🇺🇸 is
+// 🇦🇺 (Australia) followed by 🇸. A pair that is a flag matches as the flag, while the letters of any other pair
+// and an unpaired letter at the end of an odd run match as lone regional indicators. By default the lone letters
+// are emojis; with this option they are ignored and only flags are matched.
+func WithoutRegionalIndicators() Option {
+	return func(o *matcherOptions) {
+		o.noRegionalIndicators = true
+	}
+}
+
+// WithVersionRange limits the Matcher to the emojis whose version is within the range r.
+func WithVersionRange(r VersionRange) Option {
+	return func(o *matcherOptions) {
+		o.versions = r
+	}
+}
+
+// WithEmojis adds custom emojis to the Matcher, replacing the ones with the same Character.
+// They are added after all the other options are applied. The Character of a custom emoji must be
+// a single grapheme cluster, e.g. a ZWJ sequence. CodePoints are filled in from the Character if empty.
+func WithEmojis(emojis ...Emoji) Option {
+	return func(o *matcherOptions) {
+		o.custom = append(o.custom, emojis...)
+	}
+}
+
+// WithDataset makes the Matcher use the emojis of the dataset instead of the bundled list. The other options
+// filter and extend the dataset, and AllEmojis follows the order of the file the dataset was loaded from.
+func WithDataset(d *Dataset) Option {
+	return func(o *matcherOptions) {
+		o.dataset = d
+	}
+}
+
+// New returns a new Matcher configured with the given options. Without options,
+// it behaves exactly like the package-level functions.
+func New(opts ...Option) *Matcher {
+	if len(opts) == 0 {
+		return &Matcher{emojis: emojiMap, positions: emojiOrder}
+	}
+
+	var o matcherOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	positions, base := emojiOrder, emojiMap
+	if o.dataset != nil {
+		positions = make(map[string]int, len(o.dataset.emojis))
+		base = make(map[string]Emoji, len(o.dataset.emojis))
+		for i, em := range o.dataset.emojis {
+			positions[em.Character] = i
+			base[em.Character] = em
+		}
+	}
+
+	emojis := make(map[string]Emoji, len(base))
+	for character, em := range base {
+		if o.keep(em, base) {
+			emojis[character] = em
+		}
+	}
+
+	for _, em := range o.custom {
+		if em.CodePoints == nil {
+			em.CodePoints = []rune(em.Character)
+		}
+		emojis[em.Character] = em
+	}
+
+	return &Matcher{emojis: emojis, positions: positions, strict: o.strict}
+}
+
+// keep reports whether the emoji of the base list passes the filters of the options.
+func (o *matcherOptions) keep(em Emoji, base map[string]Emoji) bool {
+	switch {
+	case o.groups != nil && !o.groups[em.Group]:
+		return false
+	case o.excludedGroups[em.Group]:
+		return false
+	case o.noComponents && em.Status == StatusComponent:
+		return false
+	case o.noTextPresentation && isTextPresentation(em, base):
+		return false
+	case o.noRegionalIndicators && isRegionalIndicatorLetter(em):
+		return false
+	default:
+		return o.versions.Contains(em.Version)
+	}
+}
+
+// isTextPresentation reports whether the emoji is a single symbol that needs U+FE0F to render as emoji
+// according to the emoji list.
+func isTextPresentation(em Emoji, emojis map[string]Emoji) bool {
+	base := withoutVariationSelectors(em.Character)
+	if len([]rune(base)) != 1 {
+		return false
+	}
+
+	qualified, ok := emojis[base+string(emojiPresentationSelector)]
+	return ok && qualified.Status == StatusFullyQualified
+}
+
+// isRegionalIndicatorLetter reports whether the emoji is a lone regional indicator such as 🇦.
+func isRegionalIndicatorLetter(em Emoji) bool {
+	runes := []rune(em.Character)
+	return len(runes) == 1 && isRegionalIndicator(runes[0])
+}
+
+// Contains checks whether given string contains emoji or not.
+func (m *Matcher) Contains(s string) bool {
+	for i, r := range s {
+		if _, ok := m.emojis[string(r)]; ok && m.presents(s[i:]) {
+			return true
+		}
+	}
+
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		if _, ok := m.lookup(gr.Str()); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllEmojis gets all emojis of the Matcher in the Unicode emoji ordering. See the package-level AllEmojis.
+func (m *Matcher) AllEmojis() []Emoji {
+	return append([]Emoji(nil), m.order.get(m.emojis, m.positions)...)
+}
+
+// Remove removes all emojis from the s string and returns a new string.
+func (m *Matcher) Remove(s string) string {
+	return m.ReplaceWithFunc(s, nil)
+}
+
+// ReplaceWith replaces all emojis from the s string with the specified rune and returns a new string.
+func (m *Matcher) ReplaceWith(s string, c rune) string {
+	replacerStr := string(c)
+	return m.ReplaceWithFunc(s, func(_ Emoji) string {
+		return replacerStr
+	})
+}
+
+// ReplaceWithSlug replaces all emojis from the s string with the emoji's slug and returns a new string.
+func (m *Matcher) ReplaceWithSlug(s string) string {
+	return m.ReplaceWithFunc(s, func(em Emoji) string {
+		return em.Slug
+	})
+}
+
+// ReplaceWithFunc replaces all emojis from the s string with the result of the replacerFn function and returns a new string.
+func (m *Matcher) ReplaceWithFunc(s string, replacer replacerFn) string {
+	var buf strings.Builder
+
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		m.replaceCluster(&buf, gr.Str(), replacer)
+	}
+
+	return strings.Map(dropVariationSelector, buf.String())
+}
+
+// replaceCluster writes the grapheme cluster to the buf, replacing it with the result of the replacer if it is an emoji.
+func (m *Matcher) replaceCluster(buf *strings.Builder, cluster string, replacer replacerFn) {
+	if em, ok := m.lookup(cluster); ok {
+		if replacer != nil {
+			buf.WriteString(replacer(em))
+		}
+		return
+	}
+
+	buf.WriteString(cluster)
+}
+
+// GetInfo returns a gomoji.Emoji model representation of provided emoji.
+// If the emoji was not found, it returns the gomoji.ErrStrNotEmoji error
+func (m *Matcher) GetInfo(emoji string) (Emoji, error) {
+	em, ok := m.emojis[emoji]
+	if !ok {
+		return Emoji{}, ErrStrNotEmoji
+	}
+
+	return em, nil
+}
+
+// CollectAll finds all emojis in given string. Unlike FindAll, this does not
+// distinct repeating occurrences of emoji. If there are no emojis it returns a nil-slice.
+func (m *Matcher) CollectAll(s string) []Emoji {
+	var emojis []Emoji
+	for _, match := range m.Matches(s) {
+		emojis = append(emojis, match.Emoji)
+	}
+
+	return emojis
+}
+
+// FindAll finds all emojis in given string in order of their first occurrence.
+// If there are no emojis it returns a nil-slice.
+func (m *Matcher) FindAll(s string) []Emoji {
+	var emojis []Emoji
+	seen := make(map[string]bool)
+	add := func(em Emoji) {
+		if !seen[em.Character] {
+			seen[em.Character] = true
+			emojis = append(emojis, em)
+		}
+	}
+
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		cluster := gr.Str()
+		if em, ok := m.lookup(cluster); ok {
+			add(em)
+			continue
+		}
+
+		// Sub-cluster analysis for partial matches
+		for i := len(cluster); i > 0; i-- {
+			if em, ok := m.lookup(cluster[:i]); ok {
+				add(em)
+				break
+			}
+		}
+	}
+
+	return emojis
+}
@@ -0,0 +1,213 @@
+package gomoji_test
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestMatcherWithoutOptions(t *testing.T) {
+	m := gomoji.New()
+
+	tests := []string{
+		"hello world",
+		"hello 🦋 world",
+		"❤ ❤︎ ❤️ 👩🏾‍💻 🇺🇸 #️⃣ ©",
+		"🦰🏻 🇦",
+	}
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			if got, want := m.Contains(s), gomoji.ContainsEmoji(s); got != want {
+				t.Errorf("Contains() = %v, want %v", got, want)
+			}
+			if got, want := m.Remove(s), gomoji.RemoveEmojis(s); got != want {
+				t.Errorf("Remove() = %q, want %q", got, want)
+			}
+			if got, want := m.ReplaceWithSlug(s), gomoji.ReplaceEmojisWithSlug(s); got != want {
+				t.Errorf("ReplaceWithSlug() = %q, want %q", got, want)
+			}
+			if got, want := m.Matches(s), gomoji.Matches(s); !reflect.DeepEqual(got, want) {
+				t.Errorf("Matches() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	if got, want := len(m.AllEmojis()), len(gomoji.AllEmojis()); got != want {
+		t.Errorf("len(AllEmojis()) = %d, want %d", got, want)
+	}
+}
+
+func TestMatcherOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []gomoji.Option
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "with groups",
+			opts:     []gomoji.Option{gomoji.WithGroups("Smileys & Emotion")},
+			inputStr: "a😀b🦋c",
+			want:     "ab🦋c",
+		},
+		{
+			name:     "without groups",
+			opts:     []gomoji.Option{gomoji.WithoutGroups("Flags", "Symbols")},
+			inputStr: "a🇺🇸b©️c😀d",
+			want:     "a🇺🇸b©cd",
+		},
+		{
+			name:     "without components",
+			opts:     []gomoji.Option{gomoji.WithoutComponents()},
+			inputStr: "a🦰b😀c",
+			want:     "a🦰bc",
+		},
+		{
+			name:     "without text presentation",
+			opts:     []gomoji.Option{gomoji.WithoutTextPresentation()},
+			inputStr: "a©b©️c™d❤️e😀f",
+			want:     "a©b©c™d❤ef",
+		},
+		{
+			name:     "without regional indicators",
+			opts:     []gomoji.Option{gomoji.WithoutRegionalIndicators()},
+			inputStr: "a🇦b🇺🇸c",
+			want:     "a🇦bc",
+		},
+		{
+			name:     "with version range",
+			opts:     []gomoji.Option{gomoji.WithVersionRange(gomoji.VersionRange{Max: gomoji.Version{Major: 13}})},
+			inputStr: "a🦋b🫠c",
+			want:     "ab🫠c",
+		},
+		{
+			name: "with custom emoji",
+			opts: []gomoji.Option{
+				gomoji.WithGroups("Smileys & Emotion"),
+				gomoji.WithEmojis(gomoji.Emoji{Slug: "gopher", Character: "🐹\u200d💻"}),
+			},
+			inputStr: "a🐹‍💻b😀c🦋",
+			want:     "abc🦋",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.New(tt.opts...).Remove(tt.inputStr); got != tt.want {
+				t.Errorf("Remove() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcherCustomEmoji(t *testing.T) {
+	m := gomoji.New(gomoji.WithEmojis(gomoji.Emoji{Slug: "gopher", Character: "🐹\u200d💻"}))
+
+	em, err := m.GetInfo("🐹\u200d💻")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+	if want := []rune("🐹\u200d💻"); !reflect.DeepEqual(em.CodePoints, want) {
+		t.Errorf("CodePoints = %U, want %U", em.CodePoints, want)
+	}
+
+	if got, want := m.ReplaceWithSlug("hi 🐹‍💻 🦋"), "hi gopher butterfly"; got != want {
+		t.Errorf("ReplaceWithSlug() = %q, want %q", got, want)
+	}
+	if !m.Contains("🐹\u200d💻") {
+		t.Error("Contains() = false, want true")
+	}
+	if _, err := gomoji.GetInfo("🐹\u200d💻"); err == nil {
+		t.Error("GetInfo() error = nil, the default matcher must not be affected")
+	}
+
+	found, err := m.GetBySlug("gopher")
+	if err != nil || len(found) != 1 || found[0].Character != "🐹\u200d💻" {
+		t.Errorf("GetBySlug() = %v, %v", found, err)
+	}
+}
+
+func TestMatcherStreams(t *testing.T) {
+	m := gomoji.New(gomoji.WithGroups("Animals & Nature"))
+	input := "a😀b🦋c"
+
+	var buf bytes.Buffer
+	w := m.NewRemovingWriter(&buf)
+	for _, r := range input {
+		if _, err := w.Write([]byte(string(r))); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if got, want := buf.String(), m.Remove(input); got != want {
+		t.Errorf("NewRemovingWriter() = %q, want %q", got, want)
+	}
+
+	sc := m.NewScanner(strings.NewReader(input))
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Match().Str)
+	}
+	if want := []string{"🦋"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewScanner() = %q, want %q", got, want)
+	}
+}
+
+func TestMatcherConcurrentUse(t *testing.T) {
+	matchers := []*gomoji.Matcher{
+		gomoji.New(gomoji.WithGroups("Smileys & Emotion")),
+		gomoji.New(gomoji.WithGroups("Animals & Nature")),
+	}
+	want := []string{"ab🦋c", "a😀bc"}
+
+	var wg sync.WaitGroup
+	for i := range matchers {
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if got := matchers[i].Remove("a😀b🦋c"); got != want[i] {
+					t.Errorf("Remove() = %q, want %q", got, want[i])
+				}
+			}(i)
+		}
+	}
+	wg.Wait()
+}
+
+func TestMatcherAllEmojis(t *testing.T) {
+	emojis := gomoji.New(gomoji.WithGroups("Flags"), gomoji.WithoutRegionalIndicators()).AllEmojis()
+	if len(emojis) == 0 {
+		t.Fatal("AllEmojis() is empty")
+	}
+
+	groups := make(map[string]bool)
+	for _, em := range emojis {
+		groups[em.Group] = true
+	}
+	var got []string
+	for group := range groups {
+		got = append(got, group)
+	}
+	sort.Strings(got)
+
+	if want := []string{"Flags"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("groups = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkMatcherRemove(b *testing.B) {
+	m := gomoji.New(gomoji.WithoutComponents(), gomoji.WithoutRegionalIndicators())
+	s := "🧖 hello 🦋 world"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Remove(s)
+	}
+}
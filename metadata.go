@@ -0,0 +1,171 @@
+package gomoji
+
+import (
+	"strconv"
+	"strings"
+)
+
+// componentVersions are the versions of the components, whose names in the bundled list carry no version.
+var componentVersions = map[string]Version{
+	"🏻": {Major: 1}, "🏼": {Major: 1}, "🏽": {Major: 1}, "🏾": {Major: 1}, "🏿": {Major: 1},
+	"🦰": {Major: 11}, "🦱": {Major: 11}, "🦳": {Major: 11}, "🦲": {Major: 11},
+}
+
+func init() {
+	addMetadata(emojiMap)
+}
+
+// addMetadata fills in Name, Version, CodePoints and Status of the generated emoji list from UnicodeName,
+// Character and Group, e.g. "E15.1 woman running facing right". Emojis whose name has no version prefix
+// and that are not components come from outside the Unicode data and keep a zero Version and StatusUnknown.
+func addMetadata(emojis map[string]Emoji) {
+	spellings := make(map[string][]rune)
+	for character, em := range emojis {
+		em.Name = em.UnicodeName
+		em.CodePoints = []rune(character)
+		if prefix, name, ok := strings.Cut(em.UnicodeName, " "); ok && strings.HasPrefix(prefix, "E") {
+			if v, err := ParseVersion(prefix); err == nil {
+				em.Name, em.Version, em.Status = name, v, StatusFullyQualified
+			}
+		}
+		if em.Group == "Component" {
+			em.Version, em.Status = componentVersions[character], StatusComponent
+		}
+		emojis[character] = em
+
+		// The fully-qualified spelling is the one with every variation selector, so it is the longest.
+		if key := withoutVariationSelectors(character); em.Status == StatusFullyQualified && len(em.CodePoints) > len(spellings[key]) {
+			spellings[key] = em.CodePoints
+		}
+	}
+
+	for character, em := range emojis {
+		if em.Status == StatusFullyQualified {
+			em.Status = qualification(em.CodePoints, spellings[withoutVariationSelectors(character)])
+			emojis[character] = em
+		}
+	}
+}
+
+// qualification returns the status of the spelling given the fully-qualified spelling of the emoji. As defined
+// by UTS #51, a spelling is minimally-qualified if its first element, the first code point with its variation
+// selector if any, is qualified, and unqualified otherwise.
+func qualification(spelling, qualified []rune) Status {
+	first := 1
+	if len(qualified) > 1 && qualified[1] == emojiPresentationSelector {
+		first = 2
+	}
+
+	switch {
+	case len(spelling) == len(qualified):
+		return StatusFullyQualified
+	case len(spelling) >= first && string(spelling[:first]) == string(qualified[:first]):
+		return StatusMinimallyQualified
+	default:
+		return StatusUnqualified
+	}
+}
+
+// Version is an emoji version such as 15.1.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// ParseVersion parses an emoji version such as "15.1" or "E15.1".
+// If the version is malformed, it returns the gomoji.ErrInvalidVersion error.
+func ParseVersion(s string) (Version, error) {
+	major, minor, ok := strings.Cut(strings.TrimPrefix(s, "E"), ".")
+	if !ok {
+		minor = "0"
+	}
+
+	maj, err := strconv.Atoi(major)
+	if err != nil || maj < 0 {
+		return Version{}, ErrInvalidVersion
+	}
+	mnr, err := strconv.Atoi(minor)
+	if err != nil || mnr < 0 {
+		return Version{}, ErrInvalidVersion
+	}
+
+	return Version{Major: maj, Minor: mnr}, nil
+}
+
+// String returns the version in the "15.1" form.
+func (v Version) String() string {
+	return strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+	return nil
+}
+
+// Status is the qualification status of an emoji spelling as published in emoji-test.txt.
+type Status uint8
+
+// Qualification statuses. StatusUnknown is used for emojis that do not come from the Unicode data.
+const (
+	StatusUnknown Status = iota
+	StatusComponent
+	StatusFullyQualified
+	StatusMinimallyQualified
+	StatusUnqualified
+)
+
+var statusNames = [...]string{
+	StatusUnknown:            "",
+	StatusComponent:          "component",
+	StatusFullyQualified:     "fully-qualified",
+	StatusMinimallyQualified: "minimally-qualified",
+	StatusUnqualified:        "unqualified",
+}
+
+// ParseStatus parses a qualification status as written in emoji-test.txt, e.g. "fully-qualified".
+// If the status is unknown, it returns the gomoji.ErrInvalidStatus error.
+func ParseStatus(s string) (Status, error) {
+	for status, name := range statusNames {
+		if name == s {
+			return Status(status), nil
+		}
+	}
+
+	return StatusUnknown, ErrInvalidStatus
+}
+
+// String returns the status as written in emoji-test.txt.
+func (s Status) String() string {
+	if int(s) >= len(statusNames) {
+		return ""
+	}
+
+	return statusNames[s]
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Status) UnmarshalText(text []byte) error {
+	parsed, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,127 @@
+package gomoji_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    gomoji.Version
+		wantErr error
+	}{
+		{input: "15.1", want: gomoji.Version{Major: 15, Minor: 1}},
+		{input: "E0.6", want: gomoji.Version{Major: 0, Minor: 6}},
+		{input: "13", want: gomoji.Version{Major: 13}},
+		{input: "", wantErr: gomoji.ErrInvalidVersion},
+		{input: "E1.x", wantErr: gomoji.ErrInvalidVersion},
+		{input: "-1.0", wantErr: gomoji.ErrInvalidVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := gomoji.ParseVersion(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for _, status := range []gomoji.Status{
+		gomoji.StatusComponent,
+		gomoji.StatusFullyQualified,
+		gomoji.StatusMinimallyQualified,
+		gomoji.StatusUnqualified,
+	} {
+		got, err := gomoji.ParseStatus(status.String())
+		if err != nil {
+			t.Fatalf("ParseStatus(%q) error = %v", status, err)
+		}
+		if got != status {
+			t.Errorf("ParseStatus(%q) = %v, want %v", status, got, status)
+		}
+	}
+
+	if _, err := gomoji.ParseStatus("qualified"); !errors.Is(err, gomoji.ErrInvalidStatus) {
+		t.Errorf("ParseStatus() error = %v, want %v", err, gomoji.ErrInvalidStatus)
+	}
+}
+
+func TestEmojiJSON(t *testing.T) {
+	em, err := gomoji.GetInfo("❤️")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	data, err := json.Marshal(em)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"version":"0.6"`) || !strings.Contains(string(data), `"status":"fully-qualified"`) {
+		t.Errorf("Marshal() = %s, want version and status as text", data)
+	}
+
+	var got gomoji.Emoji
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, em) {
+		t.Errorf("Unmarshal() = %v, want %v", got, em)
+	}
+}
+
+func TestStructuredMetadata(t *testing.T) {
+	for _, em := range gomoji.AllEmojis() {
+		if got := string(em.CodePoints); got != em.Character {
+			t.Errorf("%q: CodePoints = %q, want Character", em.Character, got)
+		}
+
+		if em.Status == gomoji.StatusUnknown || em.Status == gomoji.StatusComponent {
+			continue
+		}
+
+		want := "E" + em.Version.String() + " " + em.Name
+		if em.UnicodeName != want {
+			t.Errorf("%q: Version and Name = %q, want %q", em.Character, want, em.UnicodeName)
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		emoji string
+		want  gomoji.Status
+	}{
+		{emoji: "❤️", want: gomoji.StatusFullyQualified},
+		{emoji: "❤", want: gomoji.StatusUnqualified},
+		{emoji: "1⃣", want: gomoji.StatusUnqualified},
+		{emoji: "🏳️‍🌈", want: gomoji.StatusFullyQualified},
+		{emoji: "🏳‍🌈", want: gomoji.StatusUnqualified},
+		{emoji: "⛹️‍♀️", want: gomoji.StatusFullyQualified},
+		{emoji: "⛹️‍♀", want: gomoji.StatusMinimallyQualified},
+		{emoji: "⛹‍♀️", want: gomoji.StatusUnqualified},
+		{emoji: "👮‍♀", want: gomoji.StatusMinimallyQualified},
+		{emoji: "🦰", want: gomoji.StatusComponent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.emoji, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if em.Status != tt.want {
+				t.Errorf("Status = %v, want %v", em.Status, tt.want)
+			}
+		})
+	}
+}
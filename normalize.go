@@ -0,0 +1,181 @@
+package gomoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	textPresentationSelector  = '\uFE0E'
+	emojiPresentationSelector = '\uFE0F'
+)
+
+// Difference describes how a spelling of an emoji differs from its canonical spelling.
+type Difference uint8
+
+// Differences between a spelling and the canonical spelling of an emoji. They can be combined.
+const (
+	// DiffTextPresentation means the spelling contains the text presentation selector U+FE0E.
+	DiffTextPresentation Difference = 1 << iota
+	// DiffMissingQualifier means the spelling lacks emoji presentation selectors U+FE0F the canonical spelling has.
+	DiffMissingQualifier
+	// DiffExtraQualifier means the spelling has emoji presentation selectors U+FE0F the canonical spelling does not.
+	DiffExtraQualifier
+)
+
+// canonicalTable maps every spelling with variation selectors removed to the preferred spelling in the emoji list.
+type canonicalTable struct {
+	once      sync.Once
+	preferred map[string]string
+}
+
+// GetInfoLenient returns a gomoji.Emoji model representation of the canonical spelling of the provided emoji,
+// and how the provided spelling differs from it. Unlike GetInfo, it accepts spellings with missing, extra
+// or text presentation variation selectors, e.g. "❤", "❤︎" and "❤️" all resolve to "❤️".
+// If the emoji was not found, it returns the gomoji.ErrStrNotEmoji error.
+func GetInfoLenient(emoji string) (Emoji, Difference, error) {
+	return defaultMatcher.GetInfoLenient(emoji)
+}
+
+// GetInfoLenient returns a gomoji.Emoji model representation of the canonical spelling of the provided emoji,
+// and how the provided spelling differs from it. See the package-level GetInfoLenient.
+func (m *Matcher) GetInfoLenient(emoji string) (Emoji, Difference, error) {
+	em, ok := m.lookup(emoji)
+	if !ok {
+		return Emoji{}, 0, ErrStrNotEmoji
+	}
+
+	return em, spellingDifference(emoji, em.Character), nil
+}
+
+// lookup resolves any spelling of an emoji to its canonical spelling in the emoji list,
+// ignoring differences in variation selectors. All the matching methods share it,
+// so they agree on what is an emoji.
+func (m *Matcher) lookup(s string) (Emoji, bool) {
+	if !m.presents(s) {
+		return Emoji{}, false
+	}
+
+	character, ok := m.canonicals.get(m.emojis).preferred[withoutVariationSelectors(s)]
+	if !ok {
+		return Emoji{}, false
+	}
+
+	return m.emojis[character], true
+}
+
+func (t *canonicalTable) get(emojis map[string]Emoji) *canonicalTable {
+	t.once.Do(func() {
+		t.build(emojis)
+	})
+	return t
+}
+
+func (t *canonicalTable) build(emojis map[string]Emoji) {
+	t.preferred = make(map[string]string)
+	for character := range emojis {
+		key := withoutVariationSelectors(character)
+		if cur, ok := t.preferred[key]; !ok || preferSpelling(emojis, character, cur) {
+			t.preferred[key] = character
+		}
+	}
+}
+
+// preferredSpellings returns one spelling per emoji, sorted.
+func (m *Matcher) preferredSpellings() []string {
+	preferred := m.canonicals.get(m.emojis).preferred
+
+	spellings := make([]string, 0, len(preferred))
+	for _, character := range preferred {
+		spellings = append(spellings, character)
+	}
+	sort.Strings(spellings)
+
+	return spellings
+}
+
+// preferSpelling reports whether the spelling a is preferred over the spelling b of the same emoji.
+// Spellings are ranked by their qualification status, spellings outside the Unicode data coming last.
+func preferSpelling(emojis map[string]Emoji, a, b string) bool {
+	if aRank, bRank := statusRank(emojis[a].Status), statusRank(emojis[b].Status); aRank != bRank {
+		return aRank < bRank
+	}
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+
+	return a < b
+}
+
+func statusRank(s Status) int {
+	switch s {
+	case StatusFullyQualified, StatusComponent:
+		return 0
+	case StatusMinimallyQualified:
+		return 1
+	case StatusUnqualified:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// spellingDifference compares the presentation selectors of two spellings of the same emoji.
+func spellingDifference(spelling, canonical string) Difference {
+	var diff Difference
+	if strings.ContainsRune(spelling, textPresentationSelector) {
+		diff |= DiffTextPresentation
+	}
+
+	got, want := qualifiedRunes(spelling), qualifiedRunes(canonical)
+	for i := range want {
+		if i >= len(got) {
+			break
+		}
+		if want[i] && !got[i] {
+			diff |= DiffMissingQualifier
+		}
+		if got[i] && !want[i] {
+			diff |= DiffExtraQualifier
+		}
+	}
+
+	return diff
+}
+
+// qualifiedRunes reports for every rune of s except variation selectors whether it is followed by U+FE0F.
+func qualifiedRunes(s string) []bool {
+	var qualified []bool
+	for _, r := range s {
+		switch {
+		case r == emojiPresentationSelector && len(qualified) > 0:
+			qualified[len(qualified)-1] = true
+		case !isVariationSelector(r):
+			qualified = append(qualified, false)
+		}
+	}
+
+	return qualified
+}
+
+// withoutVariationSelectors removes all variation selectors from s. It does not allocate if there are none.
+func withoutVariationSelectors(s string) string {
+	if strings.IndexFunc(s, isVariationSelector) < 0 {
+		return s
+	}
+
+	return strings.Map(dropVariationSelector, s)
+}
+
+func isVariationSelector(r rune) bool {
+	return unicode.In(r, unicode.Variation_Selector)
+}
+
+func dropVariationSelector(r rune) rune {
+	if isVariationSelector(r) {
+		return -1
+	}
+	return r
+}
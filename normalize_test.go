@@ -0,0 +1,98 @@
+package gomoji_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestGetInfoLenient(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputEmoji    string
+		wantCharacter string
+		wantDiff      gomoji.Difference
+		wantErr       error
+	}{
+		{
+			name:          "canonical spelling",
+			inputEmoji:    "❤️",
+			wantCharacter: "❤️",
+		},
+		{
+			name:          "missing emoji presentation selector",
+			inputEmoji:    "❤",
+			wantCharacter: "❤️",
+			wantDiff:      gomoji.DiffMissingQualifier,
+		},
+		{
+			name:          "text presentation selector",
+			inputEmoji:    "❤︎",
+			wantCharacter: "❤️",
+			wantDiff:      gomoji.DiffTextPresentation | gomoji.DiffMissingQualifier,
+		},
+		{
+			name:          "superfluous emoji presentation selector",
+			inputEmoji:    "🦋️",
+			wantCharacter: "🦋",
+			wantDiff:      gomoji.DiffExtraQualifier,
+		},
+		{
+			name:          "partially qualified zero width joiner sequence",
+			inputEmoji:    "🏃‍♀",
+			wantCharacter: "🏃‍♀️",
+			wantDiff:      gomoji.DiffMissingQualifier,
+		},
+		{
+			name:          "spelling not in the dataset",
+			inputEmoji:    "👁‍🗨️",
+			wantCharacter: "👁️‍🗨️",
+			wantDiff:      gomoji.DiffMissingQualifier,
+		},
+		{
+			name:       "not an emoji",
+			inputEmoji: "1",
+			wantErr:    gomoji.ErrStrNotEmoji,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diff, err := gomoji.GetInfoLenient(tt.inputEmoji)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetInfoLenient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Character != tt.wantCharacter {
+				t.Errorf("GetInfoLenient() = %q, want %q", got.Character, tt.wantCharacter)
+			}
+			if diff != tt.wantDiff {
+				t.Errorf("GetInfoLenient() diff = %b, want %b", diff, tt.wantDiff)
+			}
+		})
+	}
+}
+
+func TestLenientLookupIsConsistent(t *testing.T) {
+	for _, em := range gomoji.AllEmojis() {
+		want, _, err := gomoji.GetInfoLenient(em.Character)
+		if err != nil {
+			t.Fatalf("GetInfoLenient(%q) error = %v", em.Character, err)
+		}
+
+		// Some legacy entries, e.g. olympic rings, span several grapheme clusters and never match as a whole.
+		collected := gomoji.CollectAll(em.Character)
+		if len(collected) != 1 {
+			continue
+		}
+
+		if collected[0].Character != want.Character {
+			t.Errorf("CollectAll(%q) = %q, want %q", em.Character, collected[0].Character, want.Character)
+		}
+		if got := gomoji.FindAll(em.Character); len(got) != 1 || got[0].Character != want.Character {
+			t.Errorf("FindAll(%q) = %v, want %q", em.Character, got, want.Character)
+		}
+		if !gomoji.ContainsEmoji(em.Character) {
+			t.Errorf("ContainsEmoji(%q) = false", em.Character)
+		}
+	}
+}
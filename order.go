@@ -0,0 +1,206 @@
+package gomoji
+
+import (
+	"sort"
+	"sync"
+)
+
+//go:generate go run genorder.go -output order_data.go
+
+// groupOrder and subGroupOrder follow the order of groups and subgroups in emoji-test.txt.
+var groupOrder = rankOf(
+	"Smileys & Emotion",
+	"People & Body",
+	"Component",
+	"Animals & Nature",
+	"Food & Drink",
+	"Travel & Places",
+	"Activities",
+	"Objects",
+	"Symbols",
+	"Flags",
+)
+
+var subGroupOrder = rankOf(
+	"face-smiling",
+	"face-affection",
+	"face-tongue",
+	"face-hand",
+	"face-neutral-skeptical",
+	"face-sleepy",
+	"face-unwell",
+	"face-hat",
+	"face-glasses",
+	"face-concerned",
+	"face-negative",
+	"face-costume",
+	"cat-face",
+	"monkey-face",
+	"heart",
+	"emotion",
+	"hand-fingers-open",
+	"hand-fingers-partial",
+	"hand-single-finger",
+	"hand-fingers-closed",
+	"hands",
+	"hand-prop",
+	"body-parts",
+	"person",
+	"person-gesture",
+	"person-role",
+	"person-fantasy",
+	"person-activity",
+	"person-sport",
+	"person-resting",
+	"family",
+	"person-symbol",
+	"skin-tone",
+	"hair-style",
+	"animal-mammal",
+	"animal-bird",
+	"animal-amphibian",
+	"animal-reptile",
+	"animal-marine",
+	"animal-bug",
+	"plant-flower",
+	"plant-other",
+	"food-fruit",
+	"food-vegetable",
+	"food-prepared",
+	"food-asian",
+	"food-marine",
+	"food-sweet",
+	"drink",
+	"dishware",
+	"place-map",
+	"place-geographic",
+	"place-building",
+	"place-religious",
+	"place-other",
+	"transport-ground",
+	"transport-water",
+	"transport-air",
+	"hotel",
+	"time",
+	"sky & weather",
+	"event",
+	"award-medal",
+	"sport",
+	"game",
+	"arts & crafts",
+	"clothing",
+	"sound",
+	"music",
+	"musical-instrument",
+	"phone",
+	"computer",
+	"light & video",
+	"book-paper",
+	"money",
+	"mail",
+	"writing",
+	"office",
+	"lock",
+	"tool",
+	"science",
+	"medical",
+	"household",
+	"other-object",
+	"transport-sign",
+	"warning",
+	"arrow",
+	"religion",
+	"zodiac",
+	"av-symbol",
+	"gender",
+	"math",
+	"punctuation",
+	"currency",
+	"other-symbol",
+	"keycap",
+	"alphanum",
+	"geometric",
+	"flag",
+	"country-flag",
+	"subdivision-flag",
+)
+
+// orderTable holds the emoji list of a Matcher in the Unicode emoji ordering.
+type orderTable struct {
+	once   sync.Once
+	emojis []Emoji
+}
+
+func (t *orderTable) get(emojis map[string]Emoji, positions map[string]int) []Emoji {
+	t.once.Do(func() {
+		t.build(emojis, positions)
+	})
+	return t.emojis
+}
+
+// build sorts the emojis by their positions in emoji-test.txt, as generated into order_data.go or loaded with a dataset.
+// Emojis without a position, such as custom ones, come after them in the order of lessEmoji.
+func (t *orderTable) build(emojis map[string]Emoji, positions map[string]int) {
+	t.emojis = make([]Emoji, 0, len(emojis))
+	for _, em := range emojis {
+		t.emojis = append(t.emojis, em)
+	}
+
+	sort.Slice(t.emojis, func(i, j int) bool {
+		a, b := t.emojis[i], t.emojis[j]
+		pa, oka := positions[a.Character]
+		pb, okb := positions[b.Character]
+		switch {
+		case oka && okb:
+			return pa < pb
+		case oka != okb:
+			return oka
+		default:
+			return lessEmoji(emojis, a, b)
+		}
+	})
+}
+
+// lessEmoji orders emojis without a position by group and subgroup as in emoji-test.txt, then by code points,
+// so the spellings of an emoji are adjacent with the preferred one first. Emojis outside the Unicode data come last.
+func lessEmoji(emojis map[string]Emoji, a, b Emoji) bool {
+	if ra, rb := rank(groupOrder, a.Group), rank(groupOrder, b.Group); ra != rb {
+		return ra < rb
+	}
+	if ra, rb := rank(subGroupOrder, a.SubGroup), rank(subGroupOrder, b.SubGroup); ra != rb {
+		return ra < rb
+	}
+	if c := compareRunes([]rune(withoutVariationSelectors(a.Character)), []rune(withoutVariationSelectors(b.Character))); c != 0 {
+		return c < 0
+	}
+
+	return preferSpelling(emojis, a.Character, b.Character)
+}
+
+func rankOf(names ...string) map[string]int {
+	ranks := make(map[string]int, len(names))
+	for i, name := range names {
+		ranks[name] = i
+	}
+
+	return ranks
+}
+
+// rank returns the position of the name in the ranks, or a position after all of them if the name is unknown.
+func rank(ranks map[string]int, name string) int {
+	if r, ok := ranks[name]; ok {
+		return r
+	}
+
+	return len(ranks)
+}
+
+func compareRunes(a, b []rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return compareInts(int(a[i]), int(b[i]))
+		}
+	}
+
+	return compareInts(len(a), len(b))
+}
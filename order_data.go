@@ -0,0 +1,5213 @@
+// Code generated by genorder.go; DO NOT EDIT.
+
+package gomoji
+
+// emojiOrder maps every spelling of emoji-test.txt to its position in the file.
+var emojiOrder = map[string]int{
+	"😀":                  0,
+	"😃":                  1,
+	"😄":                  2,
+	"😁":                  3,
+	"😆":                  4,
+	"😅":                  5,
+	"🤣":                  6,
+	"😂":                  7,
+	"🙂":                  8,
+	"🙃":                  9,
+	"🫠":                  10,
+	"😉":                  11,
+	"😊":                  12,
+	"😇":                  13,
+	"🥰":                  14,
+	"😍":                  15,
+	"🤩":                  16,
+	"😘":                  17,
+	"😗":                  18,
+	"☺️":                 19,
+	"☺":                  20,
+	"😚":                  21,
+	"😙":                  22,
+	"🥲":                  23,
+	"😋":                  24,
+	"😛":                  25,
+	"😜":                  26,
+	"🤪":                  27,
+	"😝":                  28,
+	"🤑":                  29,
+	"🤗":                  30,
+	"🤭":                  31,
+	"🫢":                  32,
+	"🫣":                  33,
+	"🤫":                  34,
+	"🤔":                  35,
+	"🫡":                  36,
+	"🤐":                  37,
+	"🤨":                  38,
+	"😐":                  39,
+	"😑":                  40,
+	"😶":                  41,
+	"🫥":                  42,
+	"😶\u200d🌫️":          43,
+	"😶\u200d🌫":           44,
+	"😏":                  45,
+	"😒":                  46,
+	"🙄":                  47,
+	"😬":                  48,
+	"😮\u200d💨":           49,
+	"🤥":                  50,
+	"🫨":                  51,
+	"🙂\u200d↔️":          52,
+	"🙂\u200d↔":           53,
+	"🙂\u200d↕️":          54,
+	"🙂\u200d↕":           55,
+	"😌":                  56,
+	"😔":                  57,
+	"😪":                  58,
+	"🤤":                  59,
+	"😴":                  60,
+	"🫩":                  61,
+	"😷":                  62,
+	"🤒":                  63,
+	"🤕":                  64,
+	"🤢":                  65,
+	"🤮":                  66,
+	"🤧":                  67,
+	"🥵":                  68,
+	"🥶":                  69,
+	"🥴":                  70,
+	"😵":                  71,
+	"😵\u200d💫":           72,
+	"🤯":                  73,
+	"🤠":                  74,
+	"🥳":                  75,
+	"🥸":                  76,
+	"😎":                  77,
+	"🤓":                  78,
+	"🧐":                  79,
+	"😕":                  80,
+	"🫤":                  81,
+	"😟":                  82,
+	"🙁":                  83,
+	"☹️":                 84,
+	"☹":                  85,
+	"😮":                  86,
+	"😯":                  87,
+	"😲":                  88,
+	"😳":                  89,
+	"🥺":                  90,
+	"🥹":                  91,
+	"😦":                  92,
+	"😧":                  93,
+	"😨":                  94,
+	"😰":                  95,
+	"😥":                  96,
+	"😢":                  97,
+	"😭":                  98,
+	"😱":                  99,
+	"😖":                  100,
+	"😣":                  101,
+	"😞":                  102,
+	"😓":                  103,
+	"😩":                  104,
+	"😫":                  105,
+	"🥱":                  106,
+	"🫪":                  107,
+	"😤":                  108,
+	"😡":                  109,
+	"😠":                  110,
+	"🤬":                  111,
+	"😈":                  112,
+	"👿":                  113,
+	"💀":                  114,
+	"☠️":                 115,
+	"☠":                  116,
+	"💩":                  117,
+	"🤡":                  118,
+	"👹":                  119,
+	"👺":                  120,
+	"👻":                  121,
+	"👽":                  122,
+	"👾":                  123,
+	"🤖":                  124,
+	"😺":                  125,
+	"😸":                  126,
+	"😹":                  127,
+	"😻":                  128,
+	"😼":                  129,
+	"😽":                  130,
+	"🙀":                  131,
+	"😿":                  132,
+	"😾":                  133,
+	"🙈":                  134,
+	"🙉":                  135,
+	"🙊":                  136,
+	"💌":                  137,
+	"💘":                  138,
+	"💝":                  139,
+	"💖":                  140,
+	"💗":                  141,
+	"💓":                  142,
+	"💞":                  143,
+	"💕":                  144,
+	"💟":                  145,
+	"❣️":                 146,
+	"❣":                  147,
+	"💔":                  148,
+	"❤️\u200d🔥":          149,
+	"❤\u200d🔥":           150,
+	"❤️\u200d🩹":          151,
+	"❤\u200d🩹":           152,
+	"❤️":                 153,
+	"❤":                  154,
+	"🩷":                  155,
+	"🧡":                  156,
+	"💛":                  157,
+	"💚":                  158,
+	"💙":                  159,
+	"🩵":                  160,
+	"💜":                  161,
+	"🤎":                  162,
+	"🖤":                  163,
+	"🩶":                  164,
+	"🤍":                  165,
+	"💋":                  166,
+	"💯":                  167,
+	"💢":                  168,
+	"💥":                  169,
+	"💫":                  170,
+	"💦":                  171,
+	"💨":                  172,
+	"🕳️":                 173,
+	"🕳":                  174,
+	"💬":                  175,
+	"👁️\u200d🗨️":         176,
+	"👁\u200d🗨️":          177,
+	"👁️\u200d🗨":          178,
+	"👁\u200d🗨":           179,
+	"🗨️":                 180,
+	"🗨":                  181,
+	"🗯️":                 182,
+	"🗯":                  183,
+	"💭":                  184,
+	"💤":                  185,
+	"🫯":                  186,
+	"👋":                  187,
+	"👋🏻":                 188,
+	"👋🏼":                 189,
+	"👋🏽":                 190,
+	"👋🏾":                 191,
+	"👋🏿":                 192,
+	"🤚":                  193,
+	"🤚🏻":                 194,
+	"🤚🏼":                 195,
+	"🤚🏽":                 196,
+	"🤚🏾":                 197,
+	"🤚🏿":                 198,
+	"🖐️":                 199,
+	"🖐":                  200,
+	"🖐🏻":                 201,
+	"🖐🏼":                 202,
+	"🖐🏽":                 203,
+	"🖐🏾":                 204,
+	"🖐🏿":                 205,
+	"✋":                  206,
+	"✋🏻":                 207,
+	"✋🏼":                 208,
+	"✋🏽":                 209,
+	"✋🏾":                 210,
+	"✋🏿":                 211,
+	"🖖":                  212,
+	"🖖🏻":                 213,
+	"🖖🏼":                 214,
+	"🖖🏽":                 215,
+	"🖖🏾":                 216,
+	"🖖🏿":                 217,
+	"🫱":                  218,
+	"🫱🏻":                 219,
+	"🫱🏼":                 220,
+	"🫱🏽":                 221,
+	"🫱🏾":                 222,
+	"🫱🏿":                 223,
+	"🫲":                  224,
+	"🫲🏻":                 225,
+	"🫲🏼":                 226,
+	"🫲🏽":                 227,
+	"🫲🏾":                 228,
+	"🫲🏿":                 229,
+	"🫳":                  230,
+	"🫳🏻":                 231,
+	"🫳🏼":                 232,
+	"🫳🏽":                 233,
+	"🫳🏾":                 234,
+	"🫳🏿":                 235,
+	"🫴":                  236,
+	"🫴🏻":                 237,
+	"🫴🏼":                 238,
+	"🫴🏽":                 239,
+	"🫴🏾":                 240,
+	"🫴🏿":                 241,
+	"🫷":                  242,
+	"🫷🏻":                 243,
+	"🫷🏼":                 244,
+	"🫷🏽":                 245,
+	"🫷🏾":                 246,
+	"🫷🏿":                 247,
+	"🫸":                  248,
+	"🫸🏻":                 249,
+	"🫸🏼":                 250,
+	"🫸🏽":                 251,
+	"🫸🏾":                 252,
+	"🫸🏿":                 253,
+	"👌":                  254,
+	"👌🏻":                 255,
+	"👌🏼":                 256,
+	"👌🏽":                 257,
+	"👌🏾":                 258,
+	"👌🏿":                 259,
+	"🤌":                  260,
+	"🤌🏻":                 261,
+	"🤌🏼":                 262,
+	"🤌🏽":                 263,
+	"🤌🏾":                 264,
+	"🤌🏿":                 265,
+	"🤏":                  266,
+	"🤏🏻":                 267,
+	"🤏🏼":                 268,
+	"🤏🏽":                 269,
+	"🤏🏾":                 270,
+	"🤏🏿":                 271,
+	"✌️":                 272,
+	"✌":                  273,
+	"✌🏻":                 274,
+	"✌🏼":                 275,
+	"✌🏽":                 276,
+	"✌🏾":                 277,
+	"✌🏿":                 278,
+	"🤞":                  279,
+	"🤞🏻":                 280,
+	"🤞🏼":                 281,
+	"🤞🏽":                 282,
+	"🤞🏾":                 283,
+	"🤞🏿":                 284,
+	"🫰":                  285,
+	"🫰🏻":                 286,
+	"🫰🏼":                 287,
+	"🫰🏽":                 288,
+	"🫰🏾":                 289,
+	"🫰🏿":                 290,
+	"🤟":                  291,
+	"🤟🏻":                 292,
+	"🤟🏼":                 293,
+	"🤟🏽":                 294,
+	"🤟🏾":                 295,
+	"🤟🏿":                 296,
+	"🤘":                  297,
+	"🤘🏻":                 298,
+	"🤘🏼":                 299,
+	"🤘🏽":                 300,
+	"🤘🏾":                 301,
+	"🤘🏿":                 302,
+	"🤙":                  303,
+	"🤙🏻":                 304,
+	"🤙🏼":                 305,
+	"🤙🏽":                 306,
+	"🤙🏾":                 307,
+	"🤙🏿":                 308,
+	"👈":                  309,
+	"👈🏻":                 310,
+	"👈🏼":                 311,
+	"👈🏽":                 312,
+	"👈🏾":                 313,
+	"👈🏿":                 314,
+	"👉":                  315,
+	"👉🏻":                 316,
+	"👉🏼":                 317,
+	"👉🏽":                 318,
+	"👉🏾":                 319,
+	"👉🏿":                 320,
+	"👆":                  321,
+	"👆🏻":                 322,
+	"👆🏼":                 323,
+	"👆🏽":                 324,
+	"👆🏾":                 325,
+	"👆🏿":                 326,
+	"🖕":                  327,
+	"🖕🏻":                 328,
+	"🖕🏼":                 329,
+	"🖕🏽":                 330,
+	"🖕🏾":                 331,
+	"🖕🏿":                 332,
+	"👇":                  333,
+	"👇🏻":                 334,
+	"👇🏼":                 335,
+	"👇🏽":                 336,
+	"👇🏾":                 337,
+	"👇🏿":                 338,
+	"☝️":                 339,
+	"☝":                  340,
+	"☝🏻":                 341,
+	"☝🏼":                 342,
+	"☝🏽":                 343,
+	"☝🏾":                 344,
+	"☝🏿":                 345,
+	"🫵":                  346,
+	"🫵🏻":                 347,
+	"🫵🏼":                 348,
+	"🫵🏽":                 349,
+	"🫵🏾":                 350,
+	"🫵🏿":                 351,
+	"👍":                  352,
+	"👍🏻":                 353,
+	"👍🏼":                 354,
+	"👍🏽":                 355,
+	"👍🏾":                 356,
+	"👍🏿":                 357,
+	"👎":                  358,
+	"👎🏻":                 359,
+	"👎🏼":                 360,
+	"👎🏽":                 361,
+	"👎🏾":                 362,
+	"👎🏿":                 363,
+	"✊":                  364,
+	"✊🏻":                 365,
+	"✊🏼":                 366,
+	"✊🏽":                 367,
+	"✊🏾":                 368,
+	"✊🏿":                 369,
+	"👊":                  370,
+	"👊🏻":                 371,
+	"👊🏼":                 372,
+	"👊🏽":                 373,
+	"👊🏾":                 374,
+	"👊🏿":                 375,
+	"🤛":                  376,
+	"🤛🏻":                 377,
+	"🤛🏼":                 378,
+	"🤛🏽":                 379,
+	"🤛🏾":                 380,
+	"🤛🏿":                 381,
+	"🤜":                  382,
+	"🤜🏻":                 383,
+	"🤜🏼":                 384,
+	"🤜🏽":                 385,
+	"🤜🏾":                 386,
+	"🤜🏿":                 387,
+	"👏":                  388,
+	"👏🏻":                 389,
+	"👏🏼":                 390,
+	"👏🏽":                 391,
+	"👏🏾":                 392,
+	"👏🏿":                 393,
+	"🙌":                  394,
+	"🙌🏻":                 395,
+	"🙌🏼":                 396,
+	"🙌🏽":                 397,
+	"🙌🏾":                 398,
+	"🙌🏿":                 399,
+	"🫶":                  400,
+	"🫶🏻":                 401,
+	"🫶🏼":                 402,
+	"🫶🏽":                 403,
+	"🫶🏾":                 404,
+	"🫶🏿":                 405,
+	"👐":                  406,
+	"👐🏻":                 407,
+	"👐🏼":                 408,
+	"👐🏽":                 409,
+	"👐🏾":                 410,
+	"👐🏿":                 411,
+	"🤲":                  412,
+	"🤲🏻":                 413,
+	"🤲🏼":                 414,
+	"🤲🏽":                 415,
+	"🤲🏾":                 416,
+	"🤲🏿":                 417,
+	"🤝":                  418,
+	"🤝🏻":                 419,
+	"🤝🏼":                 420,
+	"🤝🏽":                 421,
+	"🤝🏾":                 422,
+	"🤝🏿":                 423,
+	"🫱🏻\u200d🫲🏼":         424,
+	"🫱🏻\u200d🫲🏽":         425,
+	"🫱🏻\u200d🫲🏾":         426,
+	"🫱🏻\u200d🫲🏿":         427,
+	"🫱🏼\u200d🫲🏻":         428,
+	"🫱🏼\u200d🫲🏽":         429,
+	"🫱🏼\u200d🫲🏾":         430,
+	"🫱🏼\u200d🫲🏿":         431,
+	"🫱🏽\u200d🫲🏻":         432,
+	"🫱🏽\u200d🫲🏼":         433,
+	"🫱🏽\u200d🫲🏾":         434,
+	"🫱🏽\u200d🫲🏿":         435,
+	"🫱🏾\u200d🫲🏻":         436,
+	"🫱🏾\u200d🫲🏼":         437,
+	"🫱🏾\u200d🫲🏽":         438,
+	"🫱🏾\u200d🫲🏿":         439,
+	"🫱🏿\u200d🫲🏻":         440,
+	"🫱🏿\u200d🫲🏼":         441,
+	"🫱🏿\u200d🫲🏽":         442,
+	"🫱🏿\u200d🫲🏾":         443,
+	"🙏":                  444,
+	"🙏🏻":                 445,
+	"🙏🏼":                 446,
+	"🙏🏽":                 447,
+	"🙏🏾":                 448,
+	"🙏🏿":                 449,
+	"✍️":                 450,
+	"✍":                  451,
+	"✍🏻":                 452,
+	"✍🏼":                 453,
+	"✍🏽":                 454,
+	"✍🏾":                 455,
+	"✍🏿":                 456,
+	"💅":                  457,
+	"💅🏻":                 458,
+	"💅🏼":                 459,
+	"💅🏽":                 460,
+	"💅🏾":                 461,
+	"💅🏿":                 462,
+	"🤳":                  463,
+	"🤳🏻":                 464,
+	"🤳🏼":                 465,
+	"🤳🏽":                 466,
+	"🤳🏾":                 467,
+	"🤳🏿":                 468,
+	"💪":                  469,
+	"💪🏻":                 470,
+	"💪🏼":                 471,
+	"💪🏽":                 472,
+	"💪🏾":                 473,
+	"💪🏿":                 474,
+	"🦾":                  475,
+	"🦿":                  476,
+	"🦵":                  477,
+	"🦵🏻":                 478,
+	"🦵🏼":                 479,
+	"🦵🏽":                 480,
+	"🦵🏾":                 481,
+	"🦵🏿":                 482,
+	"🦶":                  483,
+	"🦶🏻":                 484,
+	"🦶🏼":                 485,
+	"🦶🏽":                 486,
+	"🦶🏾":                 487,
+	"🦶🏿":                 488,
+	"👂":                  489,
+	"👂🏻":                 490,
+	"👂🏼":                 491,
+	"👂🏽":                 492,
+	"👂🏾":                 493,
+	"👂🏿":                 494,
+	"🦻":                  495,
+	"🦻🏻":                 496,
+	"🦻🏼":                 497,
+	"🦻🏽":                 498,
+	"🦻🏾":                 499,
+	"🦻🏿":                 500,
+	"👃":                  501,
+	"👃🏻":                 502,
+	"👃🏼":                 503,
+	"👃🏽":                 504,
+	"👃🏾":                 505,
+	"👃🏿":                 506,
+	"🧠":                  507,
+	"🫀":                  508,
+	"🫁":                  509,
+	"🦷":                  510,
+	"🦴":                  511,
+	"👀":                  512,
+	"👁️":                 513,
+	"👁":                  514,
+	"👅":                  515,
+	"👄":                  516,
+	"🫦":                  517,
+	"👶":                  518,
+	"👶🏻":                 519,
+	"👶🏼":                 520,
+	"👶🏽":                 521,
+	"👶🏾":                 522,
+	"👶🏿":                 523,
+	"🧒":                  524,
+	"🧒🏻":                 525,
+	"🧒🏼":                 526,
+	"🧒🏽":                 527,
+	"🧒🏾":                 528,
+	"🧒🏿":                 529,
+	"👦":                  530,
+	"👦🏻":                 531,
+	"👦🏼":                 532,
+	"👦🏽":                 533,
+	"👦🏾":                 534,
+	"👦🏿":                 535,
+	"👧":                  536,
+	"👧🏻":                 537,
+	"👧🏼":                 538,
+	"👧🏽":                 539,
+	"👧🏾":                 540,
+	"👧🏿":                 541,
+	"🧑":                  542,
+	"🧑🏻":                 543,
+	"🧑🏼":                 544,
+	"🧑🏽":                 545,
+	"🧑🏾":                 546,
+	"🧑🏿":                 547,
+	"👱":                  548,
+	"👱🏻":                 549,
+	"👱🏼":                 550,
+	"👱🏽":                 551,
+	"👱🏾":                 552,
+	"👱🏿":                 553,
+	"👨":                  554,
+	"👨🏻":                 555,
+	"👨🏼":                 556,
+	"👨🏽":                 557,
+	"👨🏾":                 558,
+	"👨🏿":                 559,
+	"🧔":                  560,
+	"🧔🏻":                 561,
+	"🧔🏼":                 562,
+	"🧔🏽":                 563,
+	"🧔🏾":                 564,
+	"🧔🏿":                 565,
+	"🧔\u200d♂️":          566,
+	"🧔\u200d♂":           567,
+	"🧔🏻\u200d♂️":         568,
+	"🧔🏻\u200d♂":          569,
+	"🧔🏼\u200d♂️":         570,
+	"🧔🏼\u200d♂":          571,
+	"🧔🏽\u200d♂️":         572,
+	"🧔🏽\u200d♂":          573,
+	"🧔🏾\u200d♂️":         574,
+	"🧔🏾\u200d♂":          575,
+	"🧔🏿\u200d♂️":         576,
+	"🧔🏿\u200d♂":          577,
+	"🧔\u200d♀️":          578,
+	"🧔\u200d♀":           579,
+	"🧔🏻\u200d♀️":         580,
+	"🧔🏻\u200d♀":          581,
+	"🧔🏼\u200d♀️":         582,
+	"🧔🏼\u200d♀":          583,
+	"🧔🏽\u200d♀️":         584,
+	"🧔🏽\u200d♀":          585,
+	"🧔🏾\u200d♀️":         586,
+	"🧔🏾\u200d♀":          587,
+	"🧔🏿\u200d♀️":         588,
+	"🧔🏿\u200d♀":          589,
+	"👨\u200d🦰":           590,
+	"👨🏻\u200d🦰":          591,
+	"👨🏼\u200d🦰":          592,
+	"👨🏽\u200d🦰":          593,
+	"👨🏾\u200d🦰":          594,
+	"👨🏿\u200d🦰":          595,
+	"👨\u200d🦱":           596,
+	"👨🏻\u200d🦱":          597,
+	"👨🏼\u200d🦱":          598,
+	"👨🏽\u200d🦱":          599,
+	"👨🏾\u200d🦱":          600,
+	"👨🏿\u200d🦱":          601,
+	"👨\u200d🦳":           602,
+	"👨🏻\u200d🦳":          603,
+	"👨🏼\u200d🦳":          604,
+	"👨🏽\u200d🦳":          605,
+	"👨🏾\u200d🦳":          606,
+	"👨🏿\u200d🦳":          607,
+	"👨\u200d🦲":           608,
+	"👨🏻\u200d🦲":          609,
+	"👨🏼\u200d🦲":          610,
+	"👨🏽\u200d🦲":          611,
+	"👨🏾\u200d🦲":          612,
+	"👨🏿\u200d🦲":          613,
+	"👩":                  614,
+	"👩🏻":                 615,
+	"👩🏼":                 616,
+	"👩🏽":                 617,
+	"👩🏾":                 618,
+	"👩🏿":                 619,
+	"👩\u200d🦰":           620,
+	"👩🏻\u200d🦰":          621,
+	"👩🏼\u200d🦰":          622,
+	"👩🏽\u200d🦰":          623,
+	"👩🏾\u200d🦰":          624,
+	"👩🏿\u200d🦰":          625,
+	"🧑\u200d🦰":           626,
+	"🧑🏻\u200d🦰":          627,
+	"🧑🏼\u200d🦰":          628,
+	"🧑🏽\u200d🦰":          629,
+	"🧑🏾\u200d🦰":          630,
+	"🧑🏿\u200d🦰":          631,
+	"👩\u200d🦱":           632,
+	"👩🏻\u200d🦱":          633,
+	"👩🏼\u200d🦱":          634,
+	"👩🏽\u200d🦱":          635,
+	"👩🏾\u200d🦱":          636,
+	"👩🏿\u200d🦱":          637,
+	"🧑\u200d🦱":           638,
+	"🧑🏻\u200d🦱":          639,
+	"🧑🏼\u200d🦱":          640,
+	"🧑🏽\u200d🦱":          641,
+	"🧑🏾\u200d🦱":          642,
+	"🧑🏿\u200d🦱":          643,
+	"👩\u200d🦳":           644,
+	"👩🏻\u200d🦳":          645,
+	"👩🏼\u200d🦳":          646,
+	"👩🏽\u200d🦳":          647,
+	"👩🏾\u200d🦳":          648,
+	"👩🏿\u200d🦳":          649,
+	"🧑\u200d🦳":           650,
+	"🧑🏻\u200d🦳":          651,
+	"🧑🏼\u200d🦳":          652,
+	"🧑🏽\u200d🦳":          653,
+	"🧑🏾\u200d🦳":          654,
+	"🧑🏿\u200d🦳":          655,
+	"👩\u200d🦲":           656,
+	"👩🏻\u200d🦲":          657,
+	"👩🏼\u200d🦲":          658,
+	"👩🏽\u200d🦲":          659,
+	"👩🏾\u200d🦲":          660,
+	"👩🏿\u200d🦲":          661,
+	"🧑\u200d🦲":           662,
+	"🧑🏻\u200d🦲":          663,
+	"🧑🏼\u200d🦲":          664,
+	"🧑🏽\u200d🦲":          665,
+	"🧑🏾\u200d🦲":          666,
+	"🧑🏿\u200d🦲":          667,
+	"👱\u200d♀️":          668,
+	"👱\u200d♀":           669,
+	"👱🏻\u200d♀️":         670,
+	"👱🏻\u200d♀":          671,
+	"👱🏼\u200d♀️":         672,
+	"👱🏼\u200d♀":          673,
+	"👱🏽\u200d♀️":         674,
+	"👱🏽\u200d♀":          675,
+	"👱🏾\u200d♀️":         676,
+	"👱🏾\u200d♀":          677,
+	"👱🏿\u200d♀️":         678,
+	"👱🏿\u200d♀":          679,
+	"👱\u200d♂️":          680,
+	"👱\u200d♂":           681,
+	"👱🏻\u200d♂️":         682,
+	"👱🏻\u200d♂":          683,
+	"👱🏼\u200d♂️":         684,
+	"👱🏼\u200d♂":          685,
+	"👱🏽\u200d♂️":         686,
+	"👱🏽\u200d♂":          687,
+	"👱🏾\u200d♂️":         688,
+	"👱🏾\u200d♂":          689,
+	"👱🏿\u200d♂️":         690,
+	"👱🏿\u200d♂":          691,
+	"🧓":                  692,
+	"🧓🏻":                 693,
+	"🧓🏼":                 694,
+	"🧓🏽":                 695,
+	"🧓🏾":                 696,
+	"🧓🏿":                 697,
+	"👴":                  698,
+	"👴🏻":                 699,
+	"👴🏼":                 700,
+	"👴🏽":                 701,
+	"👴🏾":                 702,
+	"👴🏿":                 703,
+	"👵":                  704,
+	"👵🏻":                 705,
+	"👵🏼":                 706,
+	"👵🏽":                 707,
+	"👵🏾":                 708,
+	"👵🏿":                 709,
+	"🙍":                  710,
+	"🙍🏻":                 711,
+	"🙍🏼":                 712,
+	"🙍🏽":                 713,
+	"🙍🏾":                 714,
+	"🙍🏿":                 715,
+	"🙍\u200d♂️":          716,
+	"🙍\u200d♂":           717,
+	"🙍🏻\u200d♂️":         718,
+	"🙍🏻\u200d♂":          719,
+	"🙍🏼\u200d♂️":         720,
+	"🙍🏼\u200d♂":          721,
+	"🙍🏽\u200d♂️":         722,
+	"🙍🏽\u200d♂":          723,
+	"🙍🏾\u200d♂️":         724,
+	"🙍🏾\u200d♂":          725,
+	"🙍🏿\u200d♂️":         726,
+	"🙍🏿\u200d♂":          727,
+	"🙍\u200d♀️":          728,
+	"🙍\u200d♀":           729,
+	"🙍🏻\u200d♀️":         730,
+	"🙍🏻\u200d♀":          731,
+	"🙍🏼\u200d♀️":         732,
+	"🙍🏼\u200d♀":          733,
+	"🙍🏽\u200d♀️":         734,
+	"🙍🏽\u200d♀":          735,
+	"🙍🏾\u200d♀️":         736,
+	"🙍🏾\u200d♀":          737,
+	"🙍🏿\u200d♀️":         738,
+	"🙍🏿\u200d♀":          739,
+	"🙎":                  740,
+	"🙎🏻":                 741,
+	"🙎🏼":                 742,
+	"🙎🏽":                 743,
+	"🙎🏾":                 744,
+	"🙎🏿":                 745,
+	"🙎\u200d♂️":          746,
+	"🙎\u200d♂":           747,
+	"🙎🏻\u200d♂️":         748,
+	"🙎🏻\u200d♂":          749,
+	"🙎🏼\u200d♂️":         750,
+	"🙎🏼\u200d♂":          751,
+	"🙎🏽\u200d♂️":         752,
+	"🙎🏽\u200d♂":          753,
+	"🙎🏾\u200d♂️":         754,
+	"🙎🏾\u200d♂":          755,
+	"🙎🏿\u200d♂️":         756,
+	"🙎🏿\u200d♂":          757,
+	"🙎\u200d♀️":          758,
+	"🙎\u200d♀":           759,
+	"🙎🏻\u200d♀️":         760,
+	"🙎🏻\u200d♀":          761,
+	"🙎🏼\u200d♀️":         762,
+	"🙎🏼\u200d♀":          763,
+	"🙎🏽\u200d♀️":         764,
+	"🙎🏽\u200d♀":          765,
+	"🙎🏾\u200d♀️":         766,
+	"🙎🏾\u200d♀":          767,
+	"🙎🏿\u200d♀️":         768,
+	"🙎🏿\u200d♀":          769,
+	"🙅":                  770,
+	"🙅🏻":                 771,
+	"🙅🏼":                 772,
+	"🙅🏽":                 773,
+	"🙅🏾":                 774,
+	"🙅🏿":                 775,
+	"🙅\u200d♂️":          776,
+	"🙅\u200d♂":           777,
+	"🙅🏻\u200d♂️":         778,
+	"🙅🏻\u200d♂":          779,
+	"🙅🏼\u200d♂️":         780,
+	"🙅🏼\u200d♂":          781,
+	"🙅🏽\u200d♂️":         782,
+	"🙅🏽\u200d♂":          783,
+	"🙅🏾\u200d♂️":         784,
+	"🙅🏾\u200d♂":          785,
+	"🙅🏿\u200d♂️":         786,
+	"🙅🏿\u200d♂":          787,
+	"🙅\u200d♀️":          788,
+	"🙅\u200d♀":           789,
+	"🙅🏻\u200d♀️":         790,
+	"🙅🏻\u200d♀":          791,
+	"🙅🏼\u200d♀️":         792,
+	"🙅🏼\u200d♀":          793,
+	"🙅🏽\u200d♀️":         794,
+	"🙅🏽\u200d♀":          795,
+	"🙅🏾\u200d♀️":         796,
+	"🙅🏾\u200d♀":          797,
+	"🙅🏿\u200d♀️":         798,
+	"🙅🏿\u200d♀":          799,
+	"🙆":                  800,
+	"🙆🏻":                 801,
+	"🙆🏼":                 802,
+	"🙆🏽":                 803,
+	"🙆🏾":                 804,
+	"🙆🏿":                 805,
+	"🙆\u200d♂️":          806,
+	"🙆\u200d♂":           807,
+	"🙆🏻\u200d♂️":         808,
+	"🙆🏻\u200d♂":          809,
+	"🙆🏼\u200d♂️":         810,
+	"🙆🏼\u200d♂":          811,
+	"🙆🏽\u200d♂️":         812,
+	"🙆🏽\u200d♂":          813,
+	"🙆🏾\u200d♂️":         814,
+	"🙆🏾\u200d♂":          815,
+	"🙆🏿\u200d♂️":         816,
+	"🙆🏿\u200d♂":          817,
+	"🙆\u200d♀️":          818,
+	"🙆\u200d♀":           819,
+	"🙆🏻\u200d♀️":         820,
+	"🙆🏻\u200d♀":          821,
+	"🙆🏼\u200d♀️":         822,
+	"🙆🏼\u200d♀":          823,
+	"🙆🏽\u200d♀️":         824,
+	"🙆🏽\u200d♀":          825,
+	"🙆🏾\u200d♀️":         826,
+	"🙆🏾\u200d♀":          827,
+	"🙆🏿\u200d♀️":         828,
+	"🙆🏿\u200d♀":          829,
+	"💁":                  830,
+	"💁🏻":                 831,
+	"💁🏼":                 832,
+	"💁🏽":                 833,
+	"💁🏾":                 834,
+	"💁🏿":                 835,
+	"💁\u200d♂️":          836,
+	"💁\u200d♂":           837,
+	"💁🏻\u200d♂️":         838,
+	"💁🏻\u200d♂":          839,
+	"💁🏼\u200d♂️":         840,
+	"💁🏼\u200d♂":          841,
+	"💁🏽\u200d♂️":         842,
+	"💁🏽\u200d♂":          843,
+	"💁🏾\u200d♂️":         844,
+	"💁🏾\u200d♂":          845,
+	"💁🏿\u200d♂️":         846,
+	"💁🏿\u200d♂":          847,
+	"💁\u200d♀️":          848,
+	"💁\u200d♀":           849,
+	"💁🏻\u200d♀️":         850,
+	"💁🏻\u200d♀":          851,
+	"💁🏼\u200d♀️":         852,
+	"💁🏼\u200d♀":          853,
+	"💁🏽\u200d♀️":         854,
+	"💁🏽\u200d♀":          855,
+	"💁🏾\u200d♀️":         856,
+	"💁🏾\u200d♀":          857,
+	"💁🏿\u200d♀️":         858,
+	"💁🏿\u200d♀":          859,
+	"🙋":                  860,
+	"🙋🏻":                 861,
+	"🙋🏼":                 862,
+	"🙋🏽":                 863,
+	"🙋🏾":                 864,
+	"🙋🏿":                 865,
+	"🙋\u200d♂️":          866,
+	"🙋\u200d♂":           867,
+	"🙋🏻\u200d♂️":         868,
+	"🙋🏻\u200d♂":          869,
+	"🙋🏼\u200d♂️":         870,
+	"🙋🏼\u200d♂":          871,
+	"🙋🏽\u200d♂️":         872,
+	"🙋🏽\u200d♂":          873,
+	"🙋🏾\u200d♂️":         874,
+	"🙋🏾\u200d♂":          875,
+	"🙋🏿\u200d♂️":         876,
+	"🙋🏿\u200d♂":          877,
+	"🙋\u200d♀️":          878,
+	"🙋\u200d♀":           879,
+	"🙋🏻\u200d♀️":         880,
+	"🙋🏻\u200d♀":          881,
+	"🙋🏼\u200d♀️":         882,
+	"🙋🏼\u200d♀":          883,
+	"🙋🏽\u200d♀️":         884,
+	"🙋🏽\u200d♀":          885,
+	"🙋🏾\u200d♀️":         886,
+	"🙋🏾\u200d♀":          887,
+	"🙋🏿\u200d♀️":         888,
+	"🙋🏿\u200d♀":          889,
+	"🧏":                  890,
+	"🧏🏻":                 891,
+	"🧏🏼":                 892,
+	"🧏🏽":                 893,
+	"🧏🏾":                 894,
+	"🧏🏿":                 895,
+	"🧏\u200d♂️":          896,
+	"🧏\u200d♂":           897,
+	"🧏🏻\u200d♂️":         898,
+	"🧏🏻\u200d♂":          899,
+	"🧏🏼\u200d♂️":         900,
+	"🧏🏼\u200d♂":          901,
+	"🧏🏽\u200d♂️":         902,
+	"🧏🏽\u200d♂":          903,
+	"🧏🏾\u200d♂️":         904,
+	"🧏🏾\u200d♂":          905,
+	"🧏🏿\u200d♂️":         906,
+	"🧏🏿\u200d♂":          907,
+	"🧏\u200d♀️":          908,
+	"🧏\u200d♀":           909,
+	"🧏🏻\u200d♀️":         910,
+	"🧏🏻\u200d♀":          911,
+	"🧏🏼\u200d♀️":         912,
+	"🧏🏼\u200d♀":          913,
+	"🧏🏽\u200d♀️":         914,
+	"🧏🏽\u200d♀":          915,
+	"🧏🏾\u200d♀️":         916,
+	"🧏🏾\u200d♀":          917,
+	"🧏🏿\u200d♀️":         918,
+	"🧏🏿\u200d♀":          919,
+	"🙇":                  920,
+	"🙇🏻":                 921,
+	"🙇🏼":                 922,
+	"🙇🏽":                 923,
+	"🙇🏾":                 924,
+	"🙇🏿":                 925,
+	"🙇\u200d♂️":          926,
+	"🙇\u200d♂":           927,
+	"🙇🏻\u200d♂️":         928,
+	"🙇🏻\u200d♂":          929,
+	"🙇🏼\u200d♂️":         930,
+	"🙇🏼\u200d♂":          931,
+	"🙇🏽\u200d♂️":         932,
+	"🙇🏽\u200d♂":          933,
+	"🙇🏾\u200d♂️":         934,
+	"🙇🏾\u200d♂":          935,
+	"🙇🏿\u200d♂️":         936,
+	"🙇🏿\u200d♂":          937,
+	"🙇\u200d♀️":          938,
+	"🙇\u200d♀":           939,
+	"🙇🏻\u200d♀️":         940,
+	"🙇🏻\u200d♀":          941,
+	"🙇🏼\u200d♀️":         942,
+	"🙇🏼\u200d♀":          943,
+	"🙇🏽\u200d♀️":         944,
+	"🙇🏽\u200d♀":          945,
+	"🙇🏾\u200d♀️":         946,
+	"🙇🏾\u200d♀":          947,
+	"🙇🏿\u200d♀️":         948,
+	"🙇🏿\u200d♀":          949,
+	"🤦":                  950,
+	"🤦🏻":                 951,
+	"🤦🏼":                 952,
+	"🤦🏽":                 953,
+	"🤦🏾":                 954,
+	"🤦🏿":                 955,
+	"🤦\u200d♂️":          956,
+	"🤦\u200d♂":           957,
+	"🤦🏻\u200d♂️":         958,
+	"🤦🏻\u200d♂":          959,
+	"🤦🏼\u200d♂️":         960,
+	"🤦🏼\u200d♂":          961,
+	"🤦🏽\u200d♂️":         962,
+	"🤦🏽\u200d♂":          963,
+	"🤦🏾\u200d♂️":         964,
+	"🤦🏾\u200d♂":          965,
+	"🤦🏿\u200d♂️":         966,
+	"🤦🏿\u200d♂":          967,
+	"🤦\u200d♀️":          968,
+	"🤦\u200d♀":           969,
+	"🤦🏻\u200d♀️":         970,
+	"🤦🏻\u200d♀":          971,
+	"🤦🏼\u200d♀️":         972,
+	"🤦🏼\u200d♀":          973,
+	"🤦🏽\u200d♀️":         974,
+	"🤦🏽\u200d♀":          975,
+	"🤦🏾\u200d♀️":         976,
+	"🤦🏾\u200d♀":          977,
+	"🤦🏿\u200d♀️":         978,
+	"🤦🏿\u200d♀":          979,
+	"🤷":                  980,
+	"🤷🏻":                 981,
+	"🤷🏼":                 982,
+	"🤷🏽":                 983,
+	"🤷🏾":                 984,
+	"🤷🏿":                 985,
+	"🤷\u200d♂️":          986,
+	"🤷\u200d♂":           987,
+	"🤷🏻\u200d♂️":         988,
+	"🤷🏻\u200d♂":          989,
+	"🤷🏼\u200d♂️":         990,
+	"🤷🏼\u200d♂":          991,
+	"🤷🏽\u200d♂️":         992,
+	"🤷🏽\u200d♂":          993,
+	"🤷🏾\u200d♂️":         994,
+	"🤷🏾\u200d♂":          995,
+	"🤷🏿\u200d♂️":         996,
+	"🤷🏿\u200d♂":          997,
+	"🤷\u200d♀️":          998,
+	"🤷\u200d♀":           999,
+	"🤷🏻\u200d♀️":         1000,
+	"🤷🏻\u200d♀":          1001,
+	"🤷🏼\u200d♀️":         1002,
+	"🤷🏼\u200d♀":          1003,
+	"🤷🏽\u200d♀️":         1004,
+	"🤷🏽\u200d♀":          1005,
+	"🤷🏾\u200d♀️":         1006,
+	"🤷🏾\u200d♀":          1007,
+	"🤷🏿\u200d♀️":         1008,
+	"🤷🏿\u200d♀":          1009,
+	"🧑\u200d⚕️":          1010,
+	"🧑\u200d⚕":           1011,
+	"🧑🏻\u200d⚕️":         1012,
+	"🧑🏻\u200d⚕":          1013,
+	"🧑🏼\u200d⚕️":         1014,
+	"🧑🏼\u200d⚕":          1015,
+	"🧑🏽\u200d⚕️":         1016,
+	"🧑🏽\u200d⚕":          1017,
+	"🧑🏾\u200d⚕️":         1018,
+	"🧑🏾\u200d⚕":          1019,
+	"🧑🏿\u200d⚕️":         1020,
+	"🧑🏿\u200d⚕":          1021,
+	"👨\u200d⚕️":          1022,
+	"👨\u200d⚕":           1023,
+	"👨🏻\u200d⚕️":         1024,
+	"👨🏻\u200d⚕":          1025,
+	"👨🏼\u200d⚕️":         1026,
+	"👨🏼\u200d⚕":          1027,
+	"👨🏽\u200d⚕️":         1028,
+	"👨🏽\u200d⚕":          1029,
+	"👨🏾\u200d⚕️":         1030,
+	"👨🏾\u200d⚕":          1031,
+	"👨🏿\u200d⚕️":         1032,
+	"👨🏿\u200d⚕":          1033,
+	"👩\u200d⚕️":          1034,
+	"👩\u200d⚕":           1035,
+	"👩🏻\u200d⚕️":         1036,
+	"👩🏻\u200d⚕":          1037,
+	"👩🏼\u200d⚕️":         1038,
+	"👩🏼\u200d⚕":          1039,
+	"👩🏽\u200d⚕️":         1040,
+	"👩🏽\u200d⚕":          1041,
+	"👩🏾\u200d⚕️":         1042,
+	"👩🏾\u200d⚕":          1043,
+	"👩🏿\u200d⚕️":         1044,
+	"👩🏿\u200d⚕":          1045,
+	"🧑\u200d🎓":           1046,
+	"🧑🏻\u200d🎓":          1047,
+	"🧑🏼\u200d🎓":          1048,
+	"🧑🏽\u200d🎓":          1049,
+	"🧑🏾\u200d🎓":          1050,
+	"🧑🏿\u200d🎓":          1051,
+	"👨\u200d🎓":           1052,
+	"👨🏻\u200d🎓":          1053,
+	"👨🏼\u200d🎓":          1054,
+	"👨🏽\u200d🎓":          1055,
+	"👨🏾\u200d🎓":          1056,
+	"👨🏿\u200d🎓":          1057,
+	"👩\u200d🎓":           1058,
+	"👩🏻\u200d🎓":          1059,
+	"👩🏼\u200d🎓":          1060,
+	"👩🏽\u200d🎓":          1061,
+	"👩🏾\u200d🎓":          1062,
+	"👩🏿\u200d🎓":          1063,
+	"🧑\u200d🏫":           1064,
+	"🧑🏻\u200d🏫":          1065,
+	"🧑🏼\u200d🏫":          1066,
+	"🧑🏽\u200d🏫":          1067,
+	"🧑🏾\u200d🏫":          1068,
+	"🧑🏿\u200d🏫":          1069,
+	"👨\u200d🏫":           1070,
+	"👨🏻\u200d🏫":          1071,
+	"👨🏼\u200d🏫":          1072,
+	"👨🏽\u200d🏫":          1073,
+	"👨🏾\u200d🏫":          1074,
+	"👨🏿\u200d🏫":          1075,
+	"👩\u200d🏫":           1076,
+	"👩🏻\u200d🏫":          1077,
+	"👩🏼\u200d🏫":          1078,
+	"👩🏽\u200d🏫":          1079,
+	"👩🏾\u200d🏫":          1080,
+	"👩🏿\u200d🏫":          1081,
+	"🧑\u200d⚖️":          1082,
+	"🧑\u200d⚖":           1083,
+	"🧑🏻\u200d⚖️":         1084,
+	"🧑🏻\u200d⚖":          1085,
+	"🧑🏼\u200d⚖️":         1086,
+	"🧑🏼\u200d⚖":          1087,
+	"🧑🏽\u200d⚖️":         1088,
+	"🧑🏽\u200d⚖":          1089,
+	"🧑🏾\u200d⚖️":         1090,
+	"🧑🏾\u200d⚖":          1091,
+	"🧑🏿\u200d⚖️":         1092,
+	"🧑🏿\u200d⚖":          1093,
+	"👨\u200d⚖️":          1094,
+	"👨\u200d⚖":           1095,
+	"👨🏻\u200d⚖️":         1096,
+	"👨🏻\u200d⚖":          1097,
+	"👨🏼\u200d⚖️":         1098,
+	"👨🏼\u200d⚖":          1099,
+	"👨🏽\u200d⚖️":         1100,
+	"👨🏽\u200d⚖":          1101,
+	"👨🏾\u200d⚖️":         1102,
+	"👨🏾\u200d⚖":          1103,
+	"👨🏿\u200d⚖️":         1104,
+	"👨🏿\u200d⚖":          1105,
+	"👩\u200d⚖️":          1106,
+	"👩\u200d⚖":           1107,
+	"👩🏻\u200d⚖️":         1108,
+	"👩🏻\u200d⚖":          1109,
+	"👩🏼\u200d⚖️":         1110,
+	"👩🏼\u200d⚖":          1111,
+	"👩🏽\u200d⚖️":         1112,
+	"👩🏽\u200d⚖":          1113,
+	"👩🏾\u200d⚖️":         1114,
+	"👩🏾\u200d⚖":          1115,
+	"👩🏿\u200d⚖️":         1116,
+	"👩🏿\u200d⚖":          1117,
+	"🧑\u200d🌾":           1118,
+	"🧑🏻\u200d🌾":          1119,
+	"🧑🏼\u200d🌾":          1120,
+	"🧑🏽\u200d🌾":          1121,
+	"🧑🏾\u200d🌾":          1122,
+	"🧑🏿\u200d🌾":          1123,
+	"👨\u200d🌾":           1124,
+	"👨🏻\u200d🌾":          1125,
+	"👨🏼\u200d🌾":          1126,
+	"👨🏽\u200d🌾":          1127,
+	"👨🏾\u200d🌾":          1128,
+	"👨🏿\u200d🌾":          1129,
+	"👩\u200d🌾":           1130,
+	"👩🏻\u200d🌾":          1131,
+	"👩🏼\u200d🌾":          1132,
+	"👩🏽\u200d🌾":          1133,
+	"👩🏾\u200d🌾":          1134,
+	"👩🏿\u200d🌾":          1135,
+	"🧑\u200d🍳":           1136,
+	"🧑🏻\u200d🍳":          1137,
+	"🧑🏼\u200d🍳":          1138,
+	"🧑🏽\u200d🍳":          1139,
+	"🧑🏾\u200d🍳":          1140,
+	"🧑🏿\u200d🍳":          1141,
+	"👨\u200d🍳":           1142,
+	"👨🏻\u200d🍳":          1143,
+	"👨🏼\u200d🍳":          1144,
+	"👨🏽\u200d🍳":          1145,
+	"👨🏾\u200d🍳":          1146,
+	"👨🏿\u200d🍳":          1147,
+	"👩\u200d🍳":           1148,
+	"👩🏻\u200d🍳":          1149,
+	"👩🏼\u200d🍳":          1150,
+	"👩🏽\u200d🍳":          1151,
+	"👩🏾\u200d🍳":          1152,
+	"👩🏿\u200d🍳":          1153,
+	"🧑\u200d🔧":           1154,
+	"🧑🏻\u200d🔧":          1155,
+	"🧑🏼\u200d🔧":          1156,
+	"🧑🏽\u200d🔧":          1157,
+	"🧑🏾\u200d🔧":          1158,
+	"🧑🏿\u200d🔧":          1159,
+	"👨\u200d🔧":           1160,
+	"👨🏻\u200d🔧":          1161,
+	"👨🏼\u200d🔧":          1162,
+	"👨🏽\u200d🔧":          1163,
+	"👨🏾\u200d🔧":          1164,
+	"👨🏿\u200d🔧":          1165,
+	"👩\u200d🔧":           1166,
+	"👩🏻\u200d🔧":          1167,
+	"👩🏼\u200d🔧":          1168,
+	"👩🏽\u200d🔧":          1169,
+	"👩🏾\u200d🔧":          1170,
+	"👩🏿\u200d🔧":          1171,
+	"🧑\u200d🏭":           1172,
+	"🧑🏻\u200d🏭":          1173,
+	"🧑🏼\u200d🏭":          1174,
+	"🧑🏽\u200d🏭":          1175,
+	"🧑🏾\u200d🏭":          1176,
+	"🧑🏿\u200d🏭":          1177,
+	"👨\u200d🏭":           1178,
+	"👨🏻\u200d🏭":          1179,
+	"👨🏼\u200d🏭":          1180,
+	"👨🏽\u200d🏭":          1181,
+	"👨🏾\u200d🏭":          1182,
+	"👨🏿\u200d🏭":          1183,
+	"👩\u200d🏭":           1184,
+	"👩🏻\u200d🏭":          1185,
+	"👩🏼\u200d🏭":          1186,
+	"👩🏽\u200d🏭":          1187,
+	"👩🏾\u200d🏭":          1188,
+	"👩🏿\u200d🏭":          1189,
+	"🧑\u200d💼":           1190,
+	"🧑🏻\u200d💼":          1191,
+	"🧑🏼\u200d💼":          1192,
+	"🧑🏽\u200d💼":          1193,
+	"🧑🏾\u200d💼":          1194,
+	"🧑🏿\u200d💼":          1195,
+	"👨\u200d💼":           1196,
+	"👨🏻\u200d💼":          1197,
+	"👨🏼\u200d💼":          1198,
+	"👨🏽\u200d💼":          1199,
+	"👨🏾\u200d💼":          1200,
+	"👨🏿\u200d💼":          1201,
+	"👩\u200d💼":           1202,
+	"👩🏻\u200d💼":          1203,
+	"👩🏼\u200d💼":          1204,
+	"👩🏽\u200d💼":          1205,
+	"👩🏾\u200d💼":          1206,
+	"👩🏿\u200d💼":          1207,
+	"🧑\u200d🔬":           1208,
+	"🧑🏻\u200d🔬":          1209,
+	"🧑🏼\u200d🔬":          1210,
+	"🧑🏽\u200d🔬":          1211,
+	"🧑🏾\u200d🔬":          1212,
+	"🧑🏿\u200d🔬":          1213,
+	"👨\u200d🔬":           1214,
+	"👨🏻\u200d🔬":          1215,
+	"👨🏼\u200d🔬":          1216,
+	"👨🏽\u200d🔬":          1217,
+	"👨🏾\u200d🔬":          1218,
+	"👨🏿\u200d🔬":          1219,
+	"👩\u200d🔬":           1220,
+	"👩🏻\u200d🔬":          1221,
+	"👩🏼\u200d🔬":          1222,
+	"👩🏽\u200d🔬":          1223,
+	"👩🏾\u200d🔬":          1224,
+	"👩🏿\u200d🔬":          1225,
+	"🧑\u200d💻":           1226,
+	"🧑🏻\u200d💻":          1227,
+	"🧑🏼\u200d💻":          1228,
+	"🧑🏽\u200d💻":          1229,
+	"🧑🏾\u200d💻":          1230,
+	"🧑🏿\u200d💻":          1231,
+	"👨\u200d💻":           1232,
+	"👨🏻\u200d💻":          1233,
+	"👨🏼\u200d💻":          1234,
+	"👨🏽\u200d💻":          1235,
+	"👨🏾\u200d💻":          1236,
+	"👨🏿\u200d💻":          1237,
+	"👩\u200d💻":           1238,
+	"👩🏻\u200d💻":          1239,
+	"👩🏼\u200d💻":          1240,
+	"👩🏽\u200d💻":          1241,
+	"👩🏾\u200d💻":          1242,
+	"👩🏿\u200d💻":          1243,
+	"🧑\u200d🎤":           1244,
+	"🧑🏻\u200d🎤":          1245,
+	"🧑🏼\u200d🎤":          1246,
+	"🧑🏽\u200d🎤":          1247,
+	"🧑🏾\u200d🎤":          1248,
+	"🧑🏿\u200d🎤":          1249,
+	"👨\u200d🎤":           1250,
+	"👨🏻\u200d🎤":          1251,
+	"👨🏼\u200d🎤":          1252,
+	"👨🏽\u200d🎤":          1253,
+	"👨🏾\u200d🎤":          1254,
+	"👨🏿\u200d🎤":          1255,
+	"👩\u200d🎤":           1256,
+	"👩🏻\u200d🎤":          1257,
+	"👩🏼\u200d🎤":          1258,
+	"👩🏽\u200d🎤":          1259,
+	"👩🏾\u200d🎤":          1260,
+	"👩🏿\u200d🎤":          1261,
+	"🧑\u200d🎨":           1262,
+	"🧑🏻\u200d🎨":          1263,
+	"🧑🏼\u200d🎨":          1264,
+	"🧑🏽\u200d🎨":          1265,
+	"🧑🏾\u200d🎨":          1266,
+	"🧑🏿\u200d🎨":          1267,
+	"👨\u200d🎨":           1268,
+	"👨🏻\u200d🎨":          1269,
+	"👨🏼\u200d🎨":          1270,
+	"👨🏽\u200d🎨":          1271,
+	"👨🏾\u200d🎨":          1272,
+	"👨🏿\u200d🎨":          1273,
+	"👩\u200d🎨":           1274,
+	"👩🏻\u200d🎨":          1275,
+	"👩🏼\u200d🎨":          1276,
+	"👩🏽\u200d🎨":          1277,
+	"👩🏾\u200d🎨":          1278,
+	"👩🏿\u200d🎨":          1279,
+	"🧑\u200d✈️":          1280,
+	"🧑\u200d✈":           1281,
+	"🧑🏻\u200d✈️":         1282,
+	"🧑🏻\u200d✈":          1283,
+	"🧑🏼\u200d✈️":         1284,
+	"🧑🏼\u200d✈":          1285,
+	"🧑🏽\u200d✈️":         1286,
+	"🧑🏽\u200d✈":          1287,
+	"🧑🏾\u200d✈️":         1288,
+	"🧑🏾\u200d✈":          1289,
+	"🧑🏿\u200d✈️":         1290,
+	"🧑🏿\u200d✈":          1291,
+	"👨\u200d✈️":          1292,
+	"👨\u200d✈":           1293,
+	"👨🏻\u200d✈️":         1294,
+	"👨🏻\u200d✈":          1295,
+	"👨🏼\u200d✈️":         1296,
+	"👨🏼\u200d✈":          1297,
+	"👨🏽\u200d✈️":         1298,
+	"👨🏽\u200d✈":          1299,
+	"👨🏾\u200d✈️":         1300,
+	"👨🏾\u200d✈":          1301,
+	"👨🏿\u200d✈️":         1302,
+	"👨🏿\u200d✈":          1303,
+	"👩\u200d✈️":          1304,
+	"👩\u200d✈":           1305,
+	"👩🏻\u200d✈️":         1306,
+	"👩🏻\u200d✈":          1307,
+	"👩🏼\u200d✈️":         1308,
+	"👩🏼\u200d✈":          1309,
+	"👩🏽\u200d✈️":         1310,
+	"👩🏽\u200d✈":          1311,
+	"👩🏾\u200d✈️":         1312,
+	"👩🏾\u200d✈":          1313,
+	"👩🏿\u200d✈️":         1314,
+	"👩🏿\u200d✈":          1315,
+	"🧑\u200d🚀":           1316,
+	"🧑🏻\u200d🚀":          1317,
+	"🧑🏼\u200d🚀":          1318,
+	"🧑🏽\u200d🚀":          1319,
+	"🧑🏾\u200d🚀":          1320,
+	"🧑🏿\u200d🚀":          1321,
+	"👨\u200d🚀":           1322,
+	"👨🏻\u200d🚀":          1323,
+	"👨🏼\u200d🚀":          1324,
+	"👨🏽\u200d🚀":          1325,
+	"👨🏾\u200d🚀":          1326,
+	"👨🏿\u200d🚀":          1327,
+	"👩\u200d🚀":           1328,
+	"👩🏻\u200d🚀":          1329,
+	"👩🏼\u200d🚀":          1330,
+	"👩🏽\u200d🚀":          1331,
+	"👩🏾\u200d🚀":          1332,
+	"👩🏿\u200d🚀":          1333,
+	"🧑\u200d🚒":           1334,
+	"🧑🏻\u200d🚒":          1335,
+	"🧑🏼\u200d🚒":          1336,
+	"🧑🏽\u200d🚒":          1337,
+	"🧑🏾\u200d🚒":          1338,
+	"🧑🏿\u200d🚒":          1339,
+	"👨\u200d🚒":           1340,
+	"👨🏻\u200d🚒":          1341,
+	"👨🏼\u200d🚒":          1342,
+	"👨🏽\u200d🚒":          1343,
+	"👨🏾\u200d🚒":          1344,
+	"👨🏿\u200d🚒":          1345,
+	"👩\u200d🚒":           1346,
+	"👩🏻\u200d🚒":          1347,
+	"👩🏼\u200d🚒":          1348,
+	"👩🏽\u200d🚒":          1349,
+	"👩🏾\u200d🚒":          1350,
+	"👩🏿\u200d🚒":          1351,
+	"👮":                  1352,
+	"👮🏻":                 1353,
+	"👮🏼":                 1354,
+	"👮🏽":                 1355,
+	"👮🏾":                 1356,
+	"👮🏿":                 1357,
+	"👮\u200d♂️":          1358,
+	"👮\u200d♂":           1359,
+	"👮🏻\u200d♂️":         1360,
+	"👮🏻\u200d♂":          1361,
+	"👮🏼\u200d♂️":         1362,
+	"👮🏼\u200d♂":          1363,
+	"👮🏽\u200d♂️":         1364,
+	"👮🏽\u200d♂":          1365,
+	"👮🏾\u200d♂️":         1366,
+	"👮🏾\u200d♂":          1367,
+	"👮🏿\u200d♂️":         1368,
+	"👮🏿\u200d♂":          1369,
+	"👮\u200d♀️":          1370,
+	"👮\u200d♀":           1371,
+	"👮🏻\u200d♀️":         1372,
+	"👮🏻\u200d♀":          1373,
+	"👮🏼\u200d♀️":         1374,
+	"👮🏼\u200d♀":          1375,
+	"👮🏽\u200d♀️":         1376,
+	"👮🏽\u200d♀":          1377,
+	"👮🏾\u200d♀️":         1378,
+	"👮🏾\u200d♀":          1379,
+	"👮🏿\u200d♀️":         1380,
+	"👮🏿\u200d♀":          1381,
+	"🕵️":                 1382,
+	"🕵":                  1383,
+	"🕵🏻":                 1384,
+	"🕵🏼":                 1385,
+	"🕵🏽":                 1386,
+	"🕵🏾":                 1387,
+	"🕵🏿":                 1388,
+	"🕵️\u200d♂️":         1389,
+	"🕵\u200d♂️":          1390,
+	"🕵️\u200d♂":          1391,
+	"🕵\u200d♂":           1392,
+	"🕵🏻\u200d♂️":         1393,
+	"🕵🏻\u200d♂":          1394,
+	"🕵🏼\u200d♂️":         1395,
+	"🕵🏼\u200d♂":          1396,
+	"🕵🏽\u200d♂️":         1397,
+	"🕵🏽\u200d♂":          1398,
+	"🕵🏾\u200d♂️":         1399,
+	"🕵🏾\u200d♂":          1400,
+	"🕵🏿\u200d♂️":         1401,
+	"🕵🏿\u200d♂":          1402,
+	"🕵️\u200d♀️":         1403,
+	"🕵\u200d♀️":          1404,
+	"🕵️\u200d♀":          1405,
+	"🕵\u200d♀":           1406,
+	"🕵🏻\u200d♀️":         1407,
+	"🕵🏻\u200d♀":          1408,
+	"🕵🏼\u200d♀️":         1409,
+	"🕵🏼\u200d♀":          1410,
+	"🕵🏽\u200d♀️":         1411,
+	"🕵🏽\u200d♀":          1412,
+	"🕵🏾\u200d♀️":         1413,
+	"🕵🏾\u200d♀":          1414,
+	"🕵🏿\u200d♀️":         1415,
+	"🕵🏿\u200d♀":          1416,
+	"💂":                  1417,
+	"💂🏻":                 1418,
+	"💂🏼":                 1419,
+	"💂🏽":                 1420,
+	"💂🏾":                 1421,
+	"💂🏿":                 1422,
+	"💂\u200d♂️":          1423,
+	"💂\u200d♂":           1424,
+	"💂🏻\u200d♂️":         1425,
+	"💂🏻\u200d♂":          1426,
+	"💂🏼\u200d♂️":         1427,
+	"💂🏼\u200d♂":          1428,
+	"💂🏽\u200d♂️":         1429,
+	"💂🏽\u200d♂":          1430,
+	"💂🏾\u200d♂️":         1431,
+	"💂🏾\u200d♂":          1432,
+	"💂🏿\u200d♂️":         1433,
+	"💂🏿\u200d♂":          1434,
+	"💂\u200d♀️":          1435,
+	"💂\u200d♀":           1436,
+	"💂🏻\u200d♀️":         1437,
+	"💂🏻\u200d♀":          1438,
+	"💂🏼\u200d♀️":         1439,
+	"💂🏼\u200d♀":          1440,
+	"💂🏽\u200d♀️":         1441,
+	"💂🏽\u200d♀":          1442,
+	"💂🏾\u200d♀️":         1443,
+	"💂🏾\u200d♀":          1444,
+	"💂🏿\u200d♀️":         1445,
+	"💂🏿\u200d♀":          1446,
+	"🥷":                  1447,
+	"🥷🏻":                 1448,
+	"🥷🏼":                 1449,
+	"🥷🏽":                 1450,
+	"🥷🏾":                 1451,
+	"🥷🏿":                 1452,
+	"👷":                  1453,
+	"👷🏻":                 1454,
+	"👷🏼":                 1455,
+	"👷🏽":                 1456,
+	"👷🏾":                 1457,
+	"👷🏿":                 1458,
+	"👷\u200d♂️":          1459,
+	"👷\u200d♂":           1460,
+	"👷🏻\u200d♂️":         1461,
+	"👷🏻\u200d♂":          1462,
+	"👷🏼\u200d♂️":         1463,
+	"👷🏼\u200d♂":          1464,
+	"👷🏽\u200d♂️":         1465,
+	"👷🏽\u200d♂":          1466,
+	"👷🏾\u200d♂️":         1467,
+	"👷🏾\u200d♂":          1468,
+	"👷🏿\u200d♂️":         1469,
+	"👷🏿\u200d♂":          1470,
+	"👷\u200d♀️":          1471,
+	"👷\u200d♀":           1472,
+	"👷🏻\u200d♀️":         1473,
+	"👷🏻\u200d♀":          1474,
+	"👷🏼\u200d♀️":         1475,
+	"👷🏼\u200d♀":          1476,
+	"👷🏽\u200d♀️":         1477,
+	"👷🏽\u200d♀":          1478,
+	"👷🏾\u200d♀️":         1479,
+	"👷🏾\u200d♀":          1480,
+	"👷🏿\u200d♀️":         1481,
+	"👷🏿\u200d♀":          1482,
+	"🫅":                  1483,
+	"🫅🏻":                 1484,
+	"🫅🏼":                 1485,
+	"🫅🏽":                 1486,
+	"🫅🏾":                 1487,
+	"🫅🏿":                 1488,
+	"🤴":                  1489,
+	"🤴🏻":                 1490,
+	"🤴🏼":                 1491,
+	"🤴🏽":                 1492,
+	"🤴🏾":                 1493,
+	"🤴🏿":                 1494,
+	"👸":                  1495,
+	"👸🏻":                 1496,
+	"👸🏼":                 1497,
+	"👸🏽":                 1498,
+	"👸🏾":                 1499,
+	"👸🏿":                 1500,
+	"👳":                  1501,
+	"👳🏻":                 1502,
+	"👳🏼":                 1503,
+	"👳🏽":                 1504,
+	"👳🏾":                 1505,
+	"👳🏿":                 1506,
+	"👳\u200d♂️":          1507,
+	"👳\u200d♂":           1508,
+	"👳🏻\u200d♂️":         1509,
+	"👳🏻\u200d♂":          1510,
+	"👳🏼\u200d♂️":         1511,
+	"👳🏼\u200d♂":          1512,
+	"👳🏽\u200d♂️":         1513,
+	"👳🏽\u200d♂":          1514,
+	"👳🏾\u200d♂️":         1515,
+	"👳🏾\u200d♂":          1516,
+	"👳🏿\u200d♂️":         1517,
+	"👳🏿\u200d♂":          1518,
+	"👳\u200d♀️":          1519,
+	"👳\u200d♀":           1520,
+	"👳🏻\u200d♀️":         1521,
+	"👳🏻\u200d♀":          1522,
+	"👳🏼\u200d♀️":         1523,
+	"👳🏼\u200d♀":          1524,
+	"👳🏽\u200d♀️":         1525,
+	"👳🏽\u200d♀":          1526,
+	"👳🏾\u200d♀️":         1527,
+	"👳🏾\u200d♀":          1528,
+	"👳🏿\u200d♀️":         1529,
+	"👳🏿\u200d♀":          1530,
+	"👲":                  1531,
+	"👲🏻":                 1532,
+	"👲🏼":                 1533,
+	"👲🏽":                 1534,
+	"👲🏾":                 1535,
+	"👲🏿":                 1536,
+	"🧕":                  1537,
+	"🧕🏻":                 1538,
+	"🧕🏼":                 1539,
+	"🧕🏽":                 1540,
+	"🧕🏾":                 1541,
+	"🧕🏿":                 1542,
+	"🤵":                  1543,
+	"🤵🏻":                 1544,
+	"🤵🏼":                 1545,
+	"🤵🏽":                 1546,
+	"🤵🏾":                 1547,
+	"🤵🏿":                 1548,
+	"🤵\u200d♂️":          1549,
+	"🤵\u200d♂":           1550,
+	"🤵🏻\u200d♂️":         1551,
+	"🤵🏻\u200d♂":          1552,
+	"🤵🏼\u200d♂️":         1553,
+	"🤵🏼\u200d♂":          1554,
+	"🤵🏽\u200d♂️":         1555,
+	"🤵🏽\u200d♂":          1556,
+	"🤵🏾\u200d♂️":         1557,
+	"🤵🏾\u200d♂":          1558,
+	"🤵🏿\u200d♂️":         1559,
+	"🤵🏿\u200d♂":          1560,
+	"🤵\u200d♀️":          1561,
+	"🤵\u200d♀":           1562,
+	"🤵🏻\u200d♀️":         1563,
+	"🤵🏻\u200d♀":          1564,
+	"🤵🏼\u200d♀️":         1565,
+	"🤵🏼\u200d♀":          1566,
+	"🤵🏽\u200d♀️":         1567,
+	"🤵🏽\u200d♀":          1568,
+	"🤵🏾\u200d♀️":         1569,
+	"🤵🏾\u200d♀":          1570,
+	"🤵🏿\u200d♀️":         1571,
+	"🤵🏿\u200d♀":          1572,
+	"👰":                  1573,
+	"👰🏻":                 1574,
+	"👰🏼":                 1575,
+	"👰🏽":                 1576,
+	"👰🏾":                 1577,
+	"👰🏿":                 1578,
+	"👰\u200d♂️":          1579,
+	"👰\u200d♂":           1580,
+	"👰🏻\u200d♂️":         1581,
+	"👰🏻\u200d♂":          1582,
+	"👰🏼\u200d♂️":         1583,
+	"👰🏼\u200d♂":          1584,
+	"👰🏽\u200d♂️":         1585,
+	"👰🏽\u200d♂":          1586,
+	"👰🏾\u200d♂️":         1587,
+	"👰🏾\u200d♂":          1588,
+	"👰🏿\u200d♂️":         1589,
+	"👰🏿\u200d♂":          1590,
+	"👰\u200d♀️":          1591,
+	"👰\u200d♀":           1592,
+	"👰🏻\u200d♀️":         1593,
+	"👰🏻\u200d♀":          1594,
+	"👰🏼\u200d♀️":         1595,
+	"👰🏼\u200d♀":          1596,
+	"👰🏽\u200d♀️":         1597,
+	"👰🏽\u200d♀":          1598,
+	"👰🏾\u200d♀️":         1599,
+	"👰🏾\u200d♀":          1600,
+	"👰🏿\u200d♀️":         1601,
+	"👰🏿\u200d♀":          1602,
+	"🤰":                  1603,
+	"🤰🏻":                 1604,
+	"🤰🏼":                 1605,
+	"🤰🏽":                 1606,
+	"🤰🏾":                 1607,
+	"🤰🏿":                 1608,
+	"🫃":                  1609,
+	"🫃🏻":                 1610,
+	"🫃🏼":                 1611,
+	"🫃🏽":                 1612,
+	"🫃🏾":                 1613,
+	"🫃🏿":                 1614,
+	"🫄":                  1615,
+	"🫄🏻":                 1616,
+	"🫄🏼":                 1617,
+	"🫄🏽":                 1618,
+	"🫄🏾":                 1619,
+	"🫄🏿":                 1620,
+	"🤱":                  1621,
+	"🤱🏻":                 1622,
+	"🤱🏼":                 1623,
+	"🤱🏽":                 1624,
+	"🤱🏾":                 1625,
+	"🤱🏿":                 1626,
+	"👩\u200d🍼":           1627,
+	"👩🏻\u200d🍼":          1628,
+	"👩🏼\u200d🍼":          1629,
+	"👩🏽\u200d🍼":          1630,
+	"👩🏾\u200d🍼":          1631,
+	"👩🏿\u200d🍼":          1632,
+	"👨\u200d🍼":           1633,
+	"👨🏻\u200d🍼":          1634,
+	"👨🏼\u200d🍼":          1635,
+	"👨🏽\u200d🍼":          1636,
+	"👨🏾\u200d🍼":          1637,
+	"👨🏿\u200d🍼":          1638,
+	"🧑\u200d🍼":           1639,
+	"🧑🏻\u200d🍼":          1640,
+	"🧑🏼\u200d🍼":          1641,
+	"🧑🏽\u200d🍼":          1642,
+	"🧑🏾\u200d🍼":          1643,
+	"🧑🏿\u200d🍼":          1644,
+	"👼":                  1645,
+	"👼🏻":                 1646,
+	"👼🏼":                 1647,
+	"👼🏽":                 1648,
+	"👼🏾":                 1649,
+	"👼🏿":                 1650,
+	"🎅":                  1651,
+	"🎅🏻":                 1652,
+	"🎅🏼":                 1653,
+	"🎅🏽":                 1654,
+	"🎅🏾":                 1655,
+	"🎅🏿":                 1656,
+	"🤶":                  1657,
+	"🤶🏻":                 1658,
+	"🤶🏼":                 1659,
+	"🤶🏽":                 1660,
+	"🤶🏾":                 1661,
+	"🤶🏿":                 1662,
+	"🧑\u200d🎄":           1663,
+	"🧑🏻\u200d🎄":          1664,
+	"🧑🏼\u200d🎄":          1665,
+	"🧑🏽\u200d🎄":          1666,
+	"🧑🏾\u200d🎄":          1667,
+	"🧑🏿\u200d🎄":          1668,
+	"🦸":                  1669,
+	"🦸🏻":                 1670,
+	"🦸🏼":                 1671,
+	"🦸🏽":                 1672,
+	"🦸🏾":                 1673,
+	"🦸🏿":                 1674,
+	"🦸\u200d♂️":          1675,
+	"🦸\u200d♂":           1676,
+	"🦸🏻\u200d♂️":         1677,
+	"🦸🏻\u200d♂":          1678,
+	"🦸🏼\u200d♂️":         1679,
+	"🦸🏼\u200d♂":          1680,
+	"🦸🏽\u200d♂️":         1681,
+	"🦸🏽\u200d♂":          1682,
+	"🦸🏾\u200d♂️":         1683,
+	"🦸🏾\u200d♂":          1684,
+	"🦸🏿\u200d♂️":         1685,
+	"🦸🏿\u200d♂":          1686,
+	"🦸\u200d♀️":          1687,
+	"🦸\u200d♀":           1688,
+	"🦸🏻\u200d♀️":         1689,
+	"🦸🏻\u200d♀":          1690,
+	"🦸🏼\u200d♀️":         1691,
+	"🦸🏼\u200d♀":          1692,
+	"🦸🏽\u200d♀️":         1693,
+	"🦸🏽\u200d♀":          1694,
+	"🦸🏾\u200d♀️":         1695,
+	"🦸🏾\u200d♀":          1696,
+	"🦸🏿\u200d♀️":         1697,
+	"🦸🏿\u200d♀":          1698,
+	"🦹":                  1699,
+	"🦹🏻":                 1700,
+	"🦹🏼":                 1701,
+	"🦹🏽":                 1702,
+	"🦹🏾":                 1703,
+	"🦹🏿":                 1704,
+	"🦹\u200d♂️":          1705,
+	"🦹\u200d♂":           1706,
+	"🦹🏻\u200d♂️":         1707,
+	"🦹🏻\u200d♂":          1708,
+	"🦹🏼\u200d♂️":         1709,
+	"🦹🏼\u200d♂":          1710,
+	"🦹🏽\u200d♂️":         1711,
+	"🦹🏽\u200d♂":          1712,
+	"🦹🏾\u200d♂️":         1713,
+	"🦹🏾\u200d♂":          1714,
+	"🦹🏿\u200d♂️":         1715,
+	"🦹🏿\u200d♂":          1716,
+	"🦹\u200d♀️":          1717,
+	"🦹\u200d♀":           1718,
+	"🦹🏻\u200d♀️":         1719,
+	"🦹🏻\u200d♀":          1720,
+	"🦹🏼\u200d♀️":         1721,
+	"🦹🏼\u200d♀":          1722,
+	"🦹🏽\u200d♀️":         1723,
+	"🦹🏽\u200d♀":          1724,
+	"🦹🏾\u200d♀️":         1725,
+	"🦹🏾\u200d♀":          1726,
+	"🦹🏿\u200d♀️":         1727,
+	"🦹🏿\u200d♀":          1728,
+	"🧙":                  1729,
+	"🧙🏻":                 1730,
+	"🧙🏼":                 1731,
+	"🧙🏽":                 1732,
+	"🧙🏾":                 1733,
+	"🧙🏿":                 1734,
+	"🧙\u200d♂️":          1735,
+	"🧙\u200d♂":           1736,
+	"🧙🏻\u200d♂️":         1737,
+	"🧙🏻\u200d♂":          1738,
+	"🧙🏼\u200d♂️":         1739,
+	"🧙🏼\u200d♂":          1740,
+	"🧙🏽\u200d♂️":         1741,
+	"🧙🏽\u200d♂":          1742,
+	"🧙🏾\u200d♂️":         1743,
+	"🧙🏾\u200d♂":          1744,
+	"🧙🏿\u200d♂️":         1745,
+	"🧙🏿\u200d♂":          1746,
+	"🧙\u200d♀️":          1747,
+	"🧙\u200d♀":           1748,
+	"🧙🏻\u200d♀️":         1749,
+	"🧙🏻\u200d♀":          1750,
+	"🧙🏼\u200d♀️":         1751,
+	"🧙🏼\u200d♀":          1752,
+	"🧙🏽\u200d♀️":         1753,
+	"🧙🏽\u200d♀":          1754,
+	"🧙🏾\u200d♀️":         1755,
+	"🧙🏾\u200d♀":          1756,
+	"🧙🏿\u200d♀️":         1757,
+	"🧙🏿\u200d♀":          1758,
+	"🧚":                  1759,
+	"🧚🏻":                 1760,
+	"🧚🏼":                 1761,
+	"🧚🏽":                 1762,
+	"🧚🏾":                 1763,
+	"🧚🏿":                 1764,
+	"🧚\u200d♂️":          1765,
+	"🧚\u200d♂":           1766,
+	"🧚🏻\u200d♂️":         1767,
+	"🧚🏻\u200d♂":          1768,
+	"🧚🏼\u200d♂️":         1769,
+	"🧚🏼\u200d♂":          1770,
+	"🧚🏽\u200d♂️":         1771,
+	"🧚🏽\u200d♂":          1772,
+	"🧚🏾\u200d♂️":         1773,
+	"🧚🏾\u200d♂":          1774,
+	"🧚🏿\u200d♂️":         1775,
+	"🧚🏿\u200d♂":          1776,
+	"🧚\u200d♀️":          1777,
+	"🧚\u200d♀":           1778,
+	"🧚🏻\u200d♀️":         1779,
+	"🧚🏻\u200d♀":          1780,
+	"🧚🏼\u200d♀️":         1781,
+	"🧚🏼\u200d♀":          1782,
+	"🧚🏽\u200d♀️":         1783,
+	"🧚🏽\u200d♀":          1784,
+	"🧚🏾\u200d♀️":         1785,
+	"🧚🏾\u200d♀":          1786,
+	"🧚🏿\u200d♀️":         1787,
+	"🧚🏿\u200d♀":          1788,
+	"🧛":                  1789,
+	"🧛🏻":                 1790,
+	"🧛🏼":                 1791,
+	"🧛🏽":                 1792,
+	"🧛🏾":                 1793,
+	"🧛🏿":                 1794,
+	"🧛\u200d♂️":          1795,
+	"🧛\u200d♂":           1796,
+	"🧛🏻\u200d♂️":         1797,
+	"🧛🏻\u200d♂":          1798,
+	"🧛🏼\u200d♂️":         1799,
+	"🧛🏼\u200d♂":          1800,
+	"🧛🏽\u200d♂️":         1801,
+	"🧛🏽\u200d♂":          1802,
+	"🧛🏾\u200d♂️":         1803,
+	"🧛🏾\u200d♂":          1804,
+	"🧛🏿\u200d♂️":         1805,
+	"🧛🏿\u200d♂":          1806,
+	"🧛\u200d♀️":          1807,
+	"🧛\u200d♀":           1808,
+	"🧛🏻\u200d♀️":         1809,
+	"🧛🏻\u200d♀":          1810,
+	"🧛🏼\u200d♀️":         1811,
+	"🧛🏼\u200d♀":          1812,
+	"🧛🏽\u200d♀️":         1813,
+	"🧛🏽\u200d♀":          1814,
+	"🧛🏾\u200d♀️":         1815,
+	"🧛🏾\u200d♀":          1816,
+	"🧛🏿\u200d♀️":         1817,
+	"🧛🏿\u200d♀":          1818,
+	"🧜":                  1819,
+	"🧜🏻":                 1820,
+	"🧜🏼":                 1821,
+	"🧜🏽":                 1822,
+	"🧜🏾":                 1823,
+	"🧜🏿":                 1824,
+	"🧜\u200d♂️":          1825,
+	"🧜\u200d♂":           1826,
+	"🧜🏻\u200d♂️":         1827,
+	"🧜🏻\u200d♂":          1828,
+	"🧜🏼\u200d♂️":         1829,
+	"🧜🏼\u200d♂":          1830,
+	"🧜🏽\u200d♂️":         1831,
+	"🧜🏽\u200d♂":          1832,
+	"🧜🏾\u200d♂️":         1833,
+	"🧜🏾\u200d♂":          1834,
+	"🧜🏿\u200d♂️":         1835,
+	"🧜🏿\u200d♂":          1836,
+	"🧜\u200d♀️":          1837,
+	"🧜\u200d♀":           1838,
+	"🧜🏻\u200d♀️":         1839,
+	"🧜🏻\u200d♀":          1840,
+	"🧜🏼\u200d♀️":         1841,
+	"🧜🏼\u200d♀":          1842,
+	"🧜🏽\u200d♀️":         1843,
+	"🧜🏽\u200d♀":          1844,
+	"🧜🏾\u200d♀️":         1845,
+	"🧜🏾\u200d♀":          1846,
+	"🧜🏿\u200d♀️":         1847,
+	"🧜🏿\u200d♀":          1848,
+	"🧝":                  1849,
+	"🧝🏻":                 1850,
+	"🧝🏼":                 1851,
+	"🧝🏽":                 1852,
+	"🧝🏾":                 1853,
+	"🧝🏿":                 1854,
+	"🧝\u200d♂️":          1855,
+	"🧝\u200d♂":           1856,
+	"🧝🏻\u200d♂️":         1857,
+	"🧝🏻\u200d♂":          1858,
+	"🧝🏼\u200d♂️":         1859,
+	"🧝🏼\u200d♂":          1860,
+	"🧝🏽\u200d♂️":         1861,
+	"🧝🏽\u200d♂":          1862,
+	"🧝🏾\u200d♂️":         1863,
+	"🧝🏾\u200d♂":          1864,
+	"🧝🏿\u200d♂️":         1865,
+	"🧝🏿\u200d♂":          1866,
+	"🧝\u200d♀️":          1867,
+	"🧝\u200d♀":           1868,
+	"🧝🏻\u200d♀️":         1869,
+	"🧝🏻\u200d♀":          1870,
+	"🧝🏼\u200d♀️":         1871,
+	"🧝🏼\u200d♀":          1872,
+	"🧝🏽\u200d♀️":         1873,
+	"🧝🏽\u200d♀":          1874,
+	"🧝🏾\u200d♀️":         1875,
+	"🧝🏾\u200d♀":          1876,
+	"🧝🏿\u200d♀️":         1877,
+	"🧝🏿\u200d♀":          1878,
+	"🧞":                  1879,
+	"🧞\u200d♂️":          1880,
+	"🧞\u200d♂":           1881,
+	"🧞\u200d♀️":          1882,
+	"🧞\u200d♀":           1883,
+	"🧟":                  1884,
+	"🧟\u200d♂️":          1885,
+	"🧟\u200d♂":           1886,
+	"🧟\u200d♀️":          1887,
+	"🧟\u200d♀":           1888,
+	"🧌":                  1889,
+	"🫈":                  1890,
+	"💆":                  1891,
+	"💆🏻":                 1892,
+	"💆🏼":                 1893,
+	"💆🏽":                 1894,
+	"💆🏾":                 1895,
+	"💆🏿":                 1896,
+	"💆\u200d♂️":          1897,
+	"💆\u200d♂":           1898,
+	"💆🏻\u200d♂️":         1899,
+	"💆🏻\u200d♂":          1900,
+	"💆🏼\u200d♂️":         1901,
+	"💆🏼\u200d♂":          1902,
+	"💆🏽\u200d♂️":         1903,
+	"💆🏽\u200d♂":          1904,
+	"💆🏾\u200d♂️":         1905,
+	"💆🏾\u200d♂":          1906,
+	"💆🏿\u200d♂️":         1907,
+	"💆🏿\u200d♂":          1908,
+	"💆\u200d♀️":          1909,
+	"💆\u200d♀":           1910,
+	"💆🏻\u200d♀️":         1911,
+	"💆🏻\u200d♀":          1912,
+	"💆🏼\u200d♀️":         1913,
+	"💆🏼\u200d♀":          1914,
+	"💆🏽\u200d♀️":         1915,
+	"💆🏽\u200d♀":          1916,
+	"💆🏾\u200d♀️":         1917,
+	"💆🏾\u200d♀":          1918,
+	"💆🏿\u200d♀️":         1919,
+	"💆🏿\u200d♀":          1920,
+	"💇":                  1921,
+	"💇🏻":                 1922,
+	"💇🏼":                 1923,
+	"💇🏽":                 1924,
+	"💇🏾":                 1925,
+	"💇🏿":                 1926,
+	"💇\u200d♂️":          1927,
+	"💇\u200d♂":           1928,
+	"💇🏻\u200d♂️":         1929,
+	"💇🏻\u200d♂":          1930,
+	"💇🏼\u200d♂️":         1931,
+	"💇🏼\u200d♂":          1932,
+	"💇🏽\u200d♂️":         1933,
+	"💇🏽\u200d♂":          1934,
+	"💇🏾\u200d♂️":         1935,
+	"💇🏾\u200d♂":          1936,
+	"💇🏿\u200d♂️":         1937,
+	"💇🏿\u200d♂":          1938,
+	"💇\u200d♀️":          1939,
+	"💇\u200d♀":           1940,
+	"💇🏻\u200d♀️":         1941,
+	"💇🏻\u200d♀":          1942,
+	"💇🏼\u200d♀️":         1943,
+	"💇🏼\u200d♀":          1944,
+	"💇🏽\u200d♀️":         1945,
+	"💇🏽\u200d♀":          1946,
+	"💇🏾\u200d♀️":         1947,
+	"💇🏾\u200d♀":          1948,
+	"💇🏿\u200d♀️":         1949,
+	"💇🏿\u200d♀":          1950,
+	"🚶":                  1951,
+	"🚶🏻":                 1952,
+	"🚶🏼":                 1953,
+	"🚶🏽":                 1954,
+	"🚶🏾":                 1955,
+	"🚶🏿":                 1956,
+	"🚶\u200d♂️":          1957,
+	"🚶\u200d♂":           1958,
+	"🚶🏻\u200d♂️":         1959,
+	"🚶🏻\u200d♂":          1960,
+	"🚶🏼\u200d♂️":         1961,
+	"🚶🏼\u200d♂":          1962,
+	"🚶🏽\u200d♂️":         1963,
+	"🚶🏽\u200d♂":          1964,
+	"🚶🏾\u200d♂️":         1965,
+	"🚶🏾\u200d♂":          1966,
+	"🚶🏿\u200d♂️":         1967,
+	"🚶🏿\u200d♂":          1968,
+	"🚶\u200d♀️":          1969,
+	"🚶\u200d♀":           1970,
+	"🚶🏻\u200d♀️":         1971,
+	"🚶🏻\u200d♀":          1972,
+	"🚶🏼\u200d♀️":         1973,
+	"🚶🏼\u200d♀":          1974,
+	"🚶🏽\u200d♀️":         1975,
+	"🚶🏽\u200d♀":          1976,
+	"🚶🏾\u200d♀️":         1977,
+	"🚶🏾\u200d♀":          1978,
+	"🚶🏿\u200d♀️":         1979,
+	"🚶🏿\u200d♀":          1980,
+	"🚶\u200d➡️":          1981,
+	"🚶\u200d➡":           1982,
+	"🚶🏻\u200d➡️":         1983,
+	"🚶🏻\u200d➡":          1984,
+	"🚶🏼\u200d➡️":         1985,
+	"🚶🏼\u200d➡":          1986,
+	"🚶🏽\u200d➡️":         1987,
+	"🚶🏽\u200d➡":          1988,
+	"🚶🏾\u200d➡️":         1989,
+	"🚶🏾\u200d➡":          1990,
+	"🚶🏿\u200d➡️":         1991,
+	"🚶🏿\u200d➡":          1992,
+	"🚶\u200d♀️\u200d➡️":  1993,
+	"🚶\u200d♀\u200d➡️":   1994,
+	"🚶\u200d♀️\u200d➡":   1995,
+	"🚶\u200d♀\u200d➡":    1996,
+	"🚶🏻\u200d♀️\u200d➡️": 1997,
+	"🚶🏻\u200d♀\u200d➡️":  1998,
+	"🚶🏻\u200d♀️\u200d➡":  1999,
+	"🚶🏻\u200d♀\u200d➡":   2000,
+	"🚶🏼\u200d♀️\u200d➡️": 2001,
+	"🚶🏼\u200d♀\u200d➡️":  2002,
+	"🚶🏼\u200d♀️\u200d➡":  2003,
+	"🚶🏼\u200d♀\u200d➡":   2004,
+	"🚶🏽\u200d♀️\u200d➡️": 2005,
+	"🚶🏽\u200d♀\u200d➡️":  2006,
+	"🚶🏽\u200d♀️\u200d➡":  2007,
+	"🚶🏽\u200d♀\u200d➡":   2008,
+	"🚶🏾\u200d♀️\u200d➡️": 2009,
+	"🚶🏾\u200d♀\u200d➡️":  2010,
+	"🚶🏾\u200d♀️\u200d➡":  2011,
+	"🚶🏾\u200d♀\u200d➡":   2012,
+	"🚶🏿\u200d♀️\u200d➡️": 2013,
+	"🚶🏿\u200d♀\u200d➡️":  2014,
+	"🚶🏿\u200d♀️\u200d➡":  2015,
+	"🚶🏿\u200d♀\u200d➡":   2016,
+	"🚶\u200d♂️\u200d➡️":  2017,
+	"🚶\u200d♂\u200d➡️":   2018,
+	"🚶\u200d♂️\u200d➡":   2019,
+	"🚶\u200d♂\u200d➡":    2020,
+	"🚶🏻\u200d♂️\u200d➡️": 2021,
+	"🚶🏻\u200d♂\u200d➡️":  2022,
+	"🚶🏻\u200d♂️\u200d➡":  2023,
+	"🚶🏻\u200d♂\u200d➡":   2024,
+	"🚶🏼\u200d♂️\u200d➡️": 2025,
+	"🚶🏼\u200d♂\u200d➡️":  2026,
+	"🚶🏼\u200d♂️\u200d➡":  2027,
+	"🚶🏼\u200d♂\u200d➡":   2028,
+	"🚶🏽\u200d♂️\u200d➡️": 2029,
+	"🚶🏽\u200d♂\u200d➡️":  2030,
+	"🚶🏽\u200d♂️\u200d➡":  2031,
+	"🚶🏽\u200d♂\u200d➡":   2032,
+	"🚶🏾\u200d♂️\u200d➡️": 2033,
+	"🚶🏾\u200d♂\u200d➡️":  2034,
+	"🚶🏾\u200d♂️\u200d➡":  2035,
+	"🚶🏾\u200d♂\u200d➡":   2036,
+	"🚶🏿\u200d♂️\u200d➡️": 2037,
+	"🚶🏿\u200d♂\u200d➡️":  2038,
+	"🚶🏿\u200d♂️\u200d➡":  2039,
+	"🚶🏿\u200d♂\u200d➡":   2040,
+	"🧍":                  2041,
+	"🧍🏻":                 2042,
+	"🧍🏼":                 2043,
+	"🧍🏽":                 2044,
+	"🧍🏾":                 2045,
+	"🧍🏿":                 2046,
+	"🧍\u200d♂️":          2047,
+	"🧍\u200d♂":           2048,
+	"🧍🏻\u200d♂️":         2049,
+	"🧍🏻\u200d♂":          2050,
+	"🧍🏼\u200d♂️":         2051,
+	"🧍🏼\u200d♂":          2052,
+	"🧍🏽\u200d♂️":         2053,
+	"🧍🏽\u200d♂":          2054,
+	"🧍🏾\u200d♂️":         2055,
+	"🧍🏾\u200d♂":          2056,
+	"🧍🏿\u200d♂️":         2057,
+	"🧍🏿\u200d♂":          2058,
+	"🧍\u200d♀️":          2059,
+	"🧍\u200d♀":           2060,
+	"🧍🏻\u200d♀️":         2061,
+	"🧍🏻\u200d♀":          2062,
+	"🧍🏼\u200d♀️":         2063,
+	"🧍🏼\u200d♀":          2064,
+	"🧍🏽\u200d♀️":         2065,
+	"🧍🏽\u200d♀":          2066,
+	"🧍🏾\u200d♀️":         2067,
+	"🧍🏾\u200d♀":          2068,
+	"🧍🏿\u200d♀️":         2069,
+	"🧍🏿\u200d♀":          2070,
+	"🧎":                  2071,
+	"🧎🏻":                 2072,
+	"🧎🏼":                 2073,
+	"🧎🏽":                 2074,
+	"🧎🏾":                 2075,
+	"🧎🏿":                 2076,
+	"🧎\u200d♂️":          2077,
+	"🧎\u200d♂":           2078,
+	"🧎🏻\u200d♂️":         2079,
+	"🧎🏻\u200d♂":          2080,
+	"🧎🏼\u200d♂️":         2081,
+	"🧎🏼\u200d♂":          2082,
+	"🧎🏽\u200d♂️":         2083,
+	"🧎🏽\u200d♂":          2084,
+	"🧎🏾\u200d♂️":         2085,
+	"🧎🏾\u200d♂":          2086,
+	"🧎🏿\u200d♂️":         2087,
+	"🧎🏿\u200d♂":          2088,
+	"🧎\u200d♀️":          2089,
+	"🧎\u200d♀":           2090,
+	"🧎🏻\u200d♀️":         2091,
+	"🧎🏻\u200d♀":          2092,
+	"🧎🏼\u200d♀️":         2093,
+	"🧎🏼\u200d♀":          2094,
+	"🧎🏽\u200d♀️":         2095,
+	"🧎🏽\u200d♀":          2096,
+	"🧎🏾\u200d♀️":         2097,
+	"🧎🏾\u200d♀":          2098,
+	"🧎🏿\u200d♀️":         2099,
+	"🧎🏿\u200d♀":          2100,
+	"🧎\u200d➡️":          2101,
+	"🧎\u200d➡":           2102,
+	"🧎🏻\u200d➡️":         2103,
+	"🧎🏻\u200d➡":          2104,
+	"🧎🏼\u200d➡️":         2105,
+	"🧎🏼\u200d➡":          2106,
+	"🧎🏽\u200d➡️":         2107,
+	"🧎🏽\u200d➡":          2108,
+	"🧎🏾\u200d➡️":         2109,
+	"🧎🏾\u200d➡":          2110,
+	"🧎🏿\u200d➡️":         2111,
+	"🧎🏿\u200d➡":          2112,
+	"🧎\u200d♀️\u200d➡️":  2113,
+	"🧎\u200d♀\u200d➡️":   2114,
+	"🧎\u200d♀️\u200d➡":   2115,
+	"🧎\u200d♀\u200d➡":    2116,
+	"🧎🏻\u200d♀️\u200d➡️": 2117,
+	"🧎🏻\u200d♀\u200d➡️":  2118,
+	"🧎🏻\u200d♀️\u200d➡":  2119,
+	"🧎🏻\u200d♀\u200d➡":   2120,
+	"🧎🏼\u200d♀️\u200d➡️": 2121,
+	"🧎🏼\u200d♀\u200d➡️":  2122,
+	"🧎🏼\u200d♀️\u200d➡":  2123,
+	"🧎🏼\u200d♀\u200d➡":   2124,
+	"🧎🏽\u200d♀️\u200d➡️": 2125,
+	"🧎🏽\u200d♀\u200d➡️":  2126,
+	"🧎🏽\u200d♀️\u200d➡":  2127,
+	"🧎🏽\u200d♀\u200d➡":   2128,
+	"🧎🏾\u200d♀️\u200d➡️": 2129,
+	"🧎🏾\u200d♀\u200d➡️":  2130,
+	"🧎🏾\u200d♀️\u200d➡":  2131,
+	"🧎🏾\u200d♀\u200d➡":   2132,
+	"🧎🏿\u200d♀️\u200d➡️": 2133,
+	"🧎🏿\u200d♀\u200d➡️":  2134,
+	"🧎🏿\u200d♀️\u200d➡":  2135,
+	"🧎🏿\u200d♀\u200d➡":   2136,
+	"🧎\u200d♂️\u200d➡️":  2137,
+	"🧎\u200d♂\u200d➡️":   2138,
+	"🧎\u200d♂️\u200d➡":   2139,
+	"🧎\u200d♂\u200d➡":    2140,
+	"🧎🏻\u200d♂️\u200d➡️": 2141,
+	"🧎🏻\u200d♂\u200d➡️":  2142,
+	"🧎🏻\u200d♂️\u200d➡":  2143,
+	"🧎🏻\u200d♂\u200d➡":   2144,
+	"🧎🏼\u200d♂️\u200d➡️": 2145,
+	"🧎🏼\u200d♂\u200d➡️":  2146,
+	"🧎🏼\u200d♂️\u200d➡":  2147,
+	"🧎🏼\u200d♂\u200d➡":   2148,
+	"🧎🏽\u200d♂️\u200d➡️": 2149,
+	"🧎🏽\u200d♂\u200d➡️":  2150,
+	"🧎🏽\u200d♂️\u200d➡":  2151,
+	"🧎🏽\u200d♂\u200d➡":   2152,
+	"🧎🏾\u200d♂️\u200d➡️": 2153,
+	"🧎🏾\u200d♂\u200d➡️":  2154,
+	"🧎🏾\u200d♂️\u200d➡":  2155,
+	"🧎🏾\u200d♂\u200d➡":   2156,
+	"🧎🏿\u200d♂️\u200d➡️": 2157,
+	"🧎🏿\u200d♂\u200d➡️":  2158,
+	"🧎🏿\u200d♂️\u200d➡":  2159,
+	"🧎🏿\u200d♂\u200d➡":   2160,
+	"🧑\u200d🦯":           2161,
+	"🧑🏻\u200d🦯":          2162,
+	"🧑🏼\u200d🦯":          2163,
+	"🧑🏽\u200d🦯":          2164,
+	"🧑🏾\u200d🦯":          2165,
+	"🧑🏿\u200d🦯":          2166,
+	"🧑\u200d🦯\u200d➡️":   2167,
+	"🧑\u200d🦯\u200d➡":    2168,
+	"🧑🏻\u200d🦯\u200d➡️":  2169,
+	"🧑🏻\u200d🦯\u200d➡":   2170,
+	"🧑🏼\u200d🦯\u200d➡️":  2171,
+	"🧑🏼\u200d🦯\u200d➡":   2172,
+	"🧑🏽\u200d🦯\u200d➡️":  2173,
+	"🧑🏽\u200d🦯\u200d➡":   2174,
+	"🧑🏾\u200d🦯\u200d➡️":  2175,
+	"🧑🏾\u200d🦯\u200d➡":   2176,
+	"🧑🏿\u200d🦯\u200d➡️":  2177,
+	"🧑🏿\u200d🦯\u200d➡":   2178,
+	"👨\u200d🦯":           2179,
+	"👨🏻\u200d🦯":          2180,
+	"👨🏼\u200d🦯":          2181,
+	"👨🏽\u200d🦯":          2182,
+	"👨🏾\u200d🦯":          2183,
+	"👨🏿\u200d🦯":          2184,
+	"👨\u200d🦯\u200d➡️":   2185,
+	"👨\u200d🦯\u200d➡":    2186,
+	"👨🏻\u200d🦯\u200d➡️":  2187,
+	"👨🏻\u200d🦯\u200d➡":   2188,
+	"👨🏼\u200d🦯\u200d➡️":  2189,
+	"👨🏼\u200d🦯\u200d➡":   2190,
+	"👨🏽\u200d🦯\u200d➡️":  2191,
+	"👨🏽\u200d🦯\u200d➡":   2192,
+	"👨🏾\u200d🦯\u200d➡️":  2193,
+	"👨🏾\u200d🦯\u200d➡":   2194,
+	"👨🏿\u200d🦯\u200d➡️":  2195,
+	"👨🏿\u200d🦯\u200d➡":   2196,
+	"👩\u200d🦯":           2197,
+	"👩🏻\u200d🦯":          2198,
+	"👩🏼\u200d🦯":          2199,
+	"👩🏽\u200d🦯":          2200,
+	"👩🏾\u200d🦯":          2201,
+	"👩🏿\u200d🦯":          2202,
+	"👩\u200d🦯\u200d➡️":   2203,
+	"👩\u200d🦯\u200d➡":    2204,
+	"👩🏻\u200d🦯\u200d➡️":  2205,
+	"👩🏻\u200d🦯\u200d➡":   2206,
+	"👩🏼\u200d🦯\u200d➡️":  2207,
+	"👩🏼\u200d🦯\u200d➡":   2208,
+	"👩🏽\u200d🦯\u200d➡️":  2209,
+	"👩🏽\u200d🦯\u200d➡":   2210,
+	"👩🏾\u200d🦯\u200d➡️":  2211,
+	"👩🏾\u200d🦯\u200d➡":   2212,
+	"👩🏿\u200d🦯\u200d➡️":  2213,
+	"👩🏿\u200d🦯\u200d➡":   2214,
+	"🧑\u200d🦼":           2215,
+	"🧑🏻\u200d🦼":          2216,
+	"🧑🏼\u200d🦼":          2217,
+	"🧑🏽\u200d🦼":          2218,
+	"🧑🏾\u200d🦼":          2219,
+	"🧑🏿\u200d🦼":          2220,
+	"🧑\u200d🦼\u200d➡️":   2221,
+	"🧑\u200d🦼\u200d➡":    2222,
+	"🧑🏻\u200d🦼\u200d➡️":  2223,
+	"🧑🏻\u200d🦼\u200d➡":   2224,
+	"🧑🏼\u200d🦼\u200d➡️":  2225,
+	"🧑🏼\u200d🦼\u200d➡":   2226,
+	"🧑🏽\u200d🦼\u200d➡️":  2227,
+	"🧑🏽\u200d🦼\u200d➡":   2228,
+	"🧑🏾\u200d🦼\u200d➡️":  2229,
+	"🧑🏾\u200d🦼\u200d➡":   2230,
+	"🧑🏿\u200d🦼\u200d➡️":  2231,
+	"🧑🏿\u200d🦼\u200d➡":   2232,
+	"👨\u200d🦼":           2233,
+	"👨🏻\u200d🦼":          2234,
+	"👨🏼\u200d🦼":          2235,
+	"👨🏽\u200d🦼":          2236,
+	"👨🏾\u200d🦼":          2237,
+	"👨🏿\u200d🦼":          2238,
+	"👨\u200d🦼\u200d➡️":   2239,
+	"👨\u200d🦼\u200d➡":    2240,
+	"👨🏻\u200d🦼\u200d➡️":  2241,
+	"👨🏻\u200d🦼\u200d➡":   2242,
+	"👨🏼\u200d🦼\u200d➡️":  2243,
+	"👨🏼\u200d🦼\u200d➡":   2244,
+	"👨🏽\u200d🦼\u200d➡️":  2245,
+	"👨🏽\u200d🦼\u200d➡":   2246,
+	"👨🏾\u200d🦼\u200d➡️":  2247,
+	"👨🏾\u200d🦼\u200d➡":   2248,
+	"👨🏿\u200d🦼\u200d➡️":  2249,
+	"👨🏿\u200d🦼\u200d➡":   2250,
+	"👩\u200d🦼":           2251,
+	"👩🏻\u200d🦼":          2252,
+	"👩🏼\u200d🦼":          2253,
+	"👩🏽\u200d🦼":          2254,
+	"👩🏾\u200d🦼":          2255,
+	"👩🏿\u200d🦼":          2256,
+	"👩\u200d🦼\u200d➡️":   2257,
+	"👩\u200d🦼\u200d➡":    2258,
+	"👩🏻\u200d🦼\u200d➡️":  2259,
+	"👩🏻\u200d🦼\u200d➡":   2260,
+	"👩🏼\u200d🦼\u200d➡️":  2261,
+	"👩🏼\u200d🦼\u200d➡":   2262,
+	"👩🏽\u200d🦼\u200d➡️":  2263,
+	"👩🏽\u200d🦼\u200d➡":   2264,
+	"👩🏾\u200d🦼\u200d➡️":  2265,
+	"👩🏾\u200d🦼\u200d➡":   2266,
+	"👩🏿\u200d🦼\u200d➡️":  2267,
+	"👩🏿\u200d🦼\u200d➡":   2268,
+	"🧑\u200d🦽":           2269,
+	"🧑🏻\u200d🦽":          2270,
+	"🧑🏼\u200d🦽":          2271,
+	"🧑🏽\u200d🦽":          2272,
+	"🧑🏾\u200d🦽":          2273,
+	"🧑🏿\u200d🦽":          2274,
+	"🧑\u200d🦽\u200d➡️":   2275,
+	"🧑\u200d🦽\u200d➡":    2276,
+	"🧑🏻\u200d🦽\u200d➡️":  2277,
+	"🧑🏻\u200d🦽\u200d➡":   2278,
+	"🧑🏼\u200d🦽\u200d➡️":  2279,
+	"🧑🏼\u200d🦽\u200d➡":   2280,
+	"🧑🏽\u200d🦽\u200d➡️":  2281,
+	"🧑🏽\u200d🦽\u200d➡":   2282,
+	"🧑🏾\u200d🦽\u200d➡️":  2283,
+	"🧑🏾\u200d🦽\u200d➡":   2284,
+	"🧑🏿\u200d🦽\u200d➡️":  2285,
+	"🧑🏿\u200d🦽\u200d➡":   2286,
+	"👨\u200d🦽":           2287,
+	"👨🏻\u200d🦽":          2288,
+	"👨🏼\u200d🦽":          2289,
+	"👨🏽\u200d🦽":          2290,
+	"👨🏾\u200d🦽":          2291,
+	"👨🏿\u200d🦽":          2292,
+	"👨\u200d🦽\u200d➡️":   2293,
+	"👨\u200d🦽\u200d➡":    2294,
+	"👨🏻\u200d🦽\u200d➡️":  2295,
+	"👨🏻\u200d🦽\u200d➡":   2296,
+	"👨🏼\u200d🦽\u200d➡️":  2297,
+	"👨🏼\u200d🦽\u200d➡":   2298,
+	"👨🏽\u200d🦽\u200d➡️":  2299,
+	"👨🏽\u200d🦽\u200d➡":   2300,
+	"👨🏾\u200d🦽\u200d➡️":  2301,
+	"👨🏾\u200d🦽\u200d➡":   2302,
+	"👨🏿\u200d🦽\u200d➡️":  2303,
+	"👨🏿\u200d🦽\u200d➡":   2304,
+	"👩\u200d🦽":           2305,
+	"👩🏻\u200d🦽":          2306,
+	"👩🏼\u200d🦽":          2307,
+	"👩🏽\u200d🦽":          2308,
+	"👩🏾\u200d🦽":          2309,
+	"👩🏿\u200d🦽":          2310,
+	"👩\u200d🦽\u200d➡️":   2311,
+	"👩\u200d🦽\u200d➡":    2312,
+	"👩🏻\u200d🦽\u200d➡️":  2313,
+	"👩🏻\u200d🦽\u200d➡":   2314,
+	"👩🏼\u200d🦽\u200d➡️":  2315,
+	"👩🏼\u200d🦽\u200d➡":   2316,
+	"👩🏽\u200d🦽\u200d➡️":  2317,
+	"👩🏽\u200d🦽\u200d➡":   2318,
+	"👩🏾\u200d🦽\u200d➡️":  2319,
+	"👩🏾\u200d🦽\u200d➡":   2320,
+	"👩🏿\u200d🦽\u200d➡️":  2321,
+	"👩🏿\u200d🦽\u200d➡":   2322,
+	"🏃":                  2323,
+	"🏃🏻":                 2324,
+	"🏃🏼":                 2325,
+	"🏃🏽":                 2326,
+	"🏃🏾":                 2327,
+	"🏃🏿":                 2328,
+	"🏃\u200d♂️":          2329,
+	"🏃\u200d♂":           2330,
+	"🏃🏻\u200d♂️":         2331,
+	"🏃🏻\u200d♂":          2332,
+	"🏃🏼\u200d♂️":         2333,
+	"🏃🏼\u200d♂":          2334,
+	"🏃🏽\u200d♂️":         2335,
+	"🏃🏽\u200d♂":          2336,
+	"🏃🏾\u200d♂️":         2337,
+	"🏃🏾\u200d♂":          2338,
+	"🏃🏿\u200d♂️":         2339,
+	"🏃🏿\u200d♂":          2340,
+	"🏃\u200d♀️":          2341,
+	"🏃\u200d♀":           2342,
+	"🏃🏻\u200d♀️":         2343,
+	"🏃🏻\u200d♀":          2344,
+	"🏃🏼\u200d♀️":         2345,
+	"🏃🏼\u200d♀":          2346,
+	"🏃🏽\u200d♀️":         2347,
+	"🏃🏽\u200d♀":          2348,
+	"🏃🏾\u200d♀️":         2349,
+	"🏃🏾\u200d♀":          2350,
+	"🏃🏿\u200d♀️":         2351,
+	"🏃🏿\u200d♀":          2352,
+	"🏃\u200d➡️":          2353,
+	"🏃\u200d➡":           2354,
+	"🏃🏻\u200d➡️":         2355,
+	"🏃🏻\u200d➡":          2356,
+	"🏃🏼\u200d➡️":         2357,
+	"🏃🏼\u200d➡":          2358,
+	"🏃🏽\u200d➡️":         2359,
+	"🏃🏽\u200d➡":          2360,
+	"🏃🏾\u200d➡️":         2361,
+	"🏃🏾\u200d➡":          2362,
+	"🏃🏿\u200d➡️":         2363,
+	"🏃🏿\u200d➡":          2364,
+	"🏃\u200d♀️\u200d➡️":  2365,
+	"🏃\u200d♀\u200d➡️":   2366,
+	"🏃\u200d♀️\u200d➡":   2367,
+	"🏃\u200d♀\u200d➡":    2368,
+	"🏃🏻\u200d♀️\u200d➡️": 2369,
+	"🏃🏻\u200d♀\u200d➡️":  2370,
+	"🏃🏻\u200d♀️\u200d➡":  2371,
+	"🏃🏻\u200d♀\u200d➡":   2372,
+	"🏃🏼\u200d♀️\u200d➡️": 2373,
+	"🏃🏼\u200d♀\u200d➡️":  2374,
+	"🏃🏼\u200d♀️\u200d➡":  2375,
+	"🏃🏼\u200d♀\u200d➡":   2376,
+	"🏃🏽\u200d♀️\u200d➡️": 2377,
+	"🏃🏽\u200d♀\u200d➡️":  2378,
+	"🏃🏽\u200d♀️\u200d➡":  2379,
+	"🏃🏽\u200d♀\u200d➡":   2380,
+	"🏃🏾\u200d♀️\u200d➡️": 2381,
+	"🏃🏾\u200d♀\u200d➡️":  2382,
+	"🏃🏾\u200d♀️\u200d➡":  2383,
+	"🏃🏾\u200d♀\u200d➡":   2384,
+	"🏃🏿\u200d♀️\u200d➡️": 2385,
+	"🏃🏿\u200d♀\u200d➡️":  2386,
+	"🏃🏿\u200d♀️\u200d➡":  2387,
+	"🏃🏿\u200d♀\u200d➡":   2388,
+	"🏃\u200d♂️\u200d➡️":  2389,
+	"🏃\u200d♂\u200d➡️":   2390,
+	"🏃\u200d♂️\u200d➡":   2391,
+	"🏃\u200d♂\u200d➡":    2392,
+	"🏃🏻\u200d♂️\u200d➡️": 2393,
+	"🏃🏻\u200d♂\u200d➡️":  2394,
+	"🏃🏻\u200d♂️\u200d➡":  2395,
+	"🏃🏻\u200d♂\u200d➡":   2396,
+	"🏃🏼\u200d♂️\u200d➡️": 2397,
+	"🏃🏼\u200d♂\u200d➡️":  2398,
+	"🏃🏼\u200d♂️\u200d➡":  2399,
+	"🏃🏼\u200d♂\u200d➡":   2400,
+	"🏃🏽\u200d♂️\u200d➡️": 2401,
+	"🏃🏽\u200d♂\u200d➡️":  2402,
+	"🏃🏽\u200d♂️\u200d➡":  2403,
+	"🏃🏽\u200d♂\u200d➡":   2404,
+	"🏃🏾\u200d♂️\u200d➡️": 2405,
+	"🏃🏾\u200d♂\u200d➡️":  2406,
+	"🏃🏾\u200d♂️\u200d➡":  2407,
+	"🏃🏾\u200d♂\u200d➡":   2408,
+	"🏃🏿\u200d♂️\u200d➡️": 2409,
+	"🏃🏿\u200d♂\u200d➡️":  2410,
+	"🏃🏿\u200d♂️\u200d➡":  2411,
+	"🏃🏿\u200d♂\u200d➡":   2412,
+	"💃":                  2413,
+	"💃🏻":                 2414,
+	"💃🏼":                 2415,
+	"💃🏽":                 2416,
+	"💃🏾":                 2417,
+	"💃🏿":                 2418,
+	"🕺":                  2419,
+	"🕺🏻":                 2420,
+	"🕺🏼":                 2421,
+	"🕺🏽":                 2422,
+	"🕺🏾":                 2423,
+	"🕺🏿":                 2424,
+	"🕴️":                 2425,
+	"🕴":                  2426,
+	"🕴🏻":                 2427,
+	"🕴🏼":                 2428,
+	"🕴🏽":                 2429,
+	"🕴🏾":                 2430,
+	"🕴🏿":                 2431,
+	"👯":                  2432,
+	"🧑🏻\u200d🐰\u200d🧑🏼":  2433,
+	"🧑🏻\u200d🐰\u200d🧑🏽":  2434,
+	"🧑🏻\u200d🐰\u200d🧑🏾":  2435,
+	"🧑🏻\u200d🐰\u200d🧑🏿":  2436,
+	"🧑🏼\u200d🐰\u200d🧑🏻":  2437,
+	"🧑🏼\u200d🐰\u200d🧑🏽":  2438,
+	"🧑🏼\u200d🐰\u200d🧑🏾":  2439,
+	"🧑🏼\u200d🐰\u200d🧑🏿":  2440,
+	"🧑🏽\u200d🐰\u200d🧑🏻":  2441,
+	"🧑🏽\u200d🐰\u200d🧑🏼":  2442,
+	"🧑🏽\u200d🐰\u200d🧑🏾":  2443,
+	"🧑🏽\u200d🐰\u200d🧑🏿":  2444,
+	"🧑🏾\u200d🐰\u200d🧑🏻":  2445,
+	"🧑🏾\u200d🐰\u200d🧑🏼":  2446,
+	"🧑🏾\u200d🐰\u200d🧑🏽":  2447,
+	"🧑🏾\u200d🐰\u200d🧑🏿":  2448,
+	"🧑🏿\u200d🐰\u200d🧑🏻":  2449,
+	"🧑🏿\u200d🐰\u200d🧑🏼":  2450,
+	"🧑🏿\u200d🐰\u200d🧑🏽":  2451,
+	"🧑🏿\u200d🐰\u200d🧑🏾":  2452,
+	"👯\u200d♂️":          2453,
+	"👯\u200d♂":           2454,
+	"👯🏻\u200d♂":          2455,
+	"👨🏻\u200d🐰\u200d👨🏼":  2456,
+	"👨🏻\u200d🐰\u200d👨🏽":  2457,
+	"👨🏻\u200d🐰\u200d👨🏾":  2458,
+	"👨🏻\u200d🐰\u200d👨🏿":  2459,
+	"👨🏼\u200d🐰\u200d👨🏻":  2460,
+	"👯🏼\u200d♂️":         2461,
+	"👯🏼\u200d♂":          2462,
+	"👨🏼\u200d🐰\u200d👨🏽":  2463,
+	"👨🏼\u200d🐰\u200d👨🏾":  2464,
+	"👨🏼\u200d🐰\u200d👨🏿":  2465,
+	"👨🏽\u200d🐰\u200d👨🏻":  2466,
+	"👨🏽\u200d🐰\u200d👨🏼":  2467,
+	"👯🏽\u200d♂":          2468,
+	"👨🏽\u200d🐰\u200d👨🏾":  2469,
+	"👨🏽\u200d🐰\u200d👨🏿":  2470,
+	"👨🏾\u200d🐰\u200d👨🏻":  2471,
+	"👨🏾\u200d🐰\u200d👨🏼":  2472,
+	"👨🏾\u200d🐰\u200d👨🏽":  2473,
+	"👯🏾\u200d♂":          2474,
+	"👨🏾\u200d🐰\u200d👨🏿":  2475,
+	"👨🏿\u200d🐰\u200d👨🏻":  2476,
+	"👨🏿\u200d🐰\u200d👨🏼":  2477,
+	"👨🏿\u200d🐰\u200d👨🏽":  2478,
+	"👨🏿\u200d🐰\u200d👨🏾":  2479,
+	"👯🏿\u200d♂":          2480,
+	"👯\u200d♀️":          2481,
+	"👯\u200d♀":           2482,
+	"👯🏻\u200d♀️":         2483,
+	"👯🏻\u200d♀":          2484,
+	"👩🏻\u200d🐰\u200d👩🏼":  2485,
+	"👩🏻\u200d🐰\u200d👩🏽":  2486,
+	"👩🏻\u200d🐰\u200d👩🏾":  2487,
+	"👩🏻\u200d🐰\u200d👩🏿":  2488,
+	"👩🏼\u200d🐰\u200d👩🏻":  2489,
+	"👯🏼\u200d♀️":         2490,
+	"👯🏼\u200d♀":          2491,
+	"👩🏼\u200d🐰\u200d👩🏽":  2492,
+	"👩🏼\u200d🐰\u200d👩🏾":  2493,
+	"👩🏼\u200d🐰\u200d👩🏿":  2494,
+	"👩🏽\u200d🐰\u200d👩🏻":  2495,
+	"👩🏽\u200d🐰\u200d👩🏼":  2496,
+	"👯🏽\u200d♀️":         2497,
+	"👯🏽\u200d♀":          2498,
+	"👩🏽\u200d🐰\u200d👩🏾":  2499,
+	"👩🏽\u200d🐰\u200d👩🏿":  2500,
+	"👩🏾\u200d🐰\u200d👩🏻":  2501,
+	"👩🏾\u200d🐰\u200d👩🏼":  2502,
+	"👩🏾\u200d🐰\u200d👩🏽":  2503,
+	"👯🏾\u200d♀️":         2504,
+	"👯🏾\u200d♀":          2505,
+	"👩🏾\u200d🐰\u200d👩🏿":  2506,
+	"👩🏿\u200d🐰\u200d👩🏻":  2507,
+	"👩🏿\u200d🐰\u200d👩🏼":  2508,
+	"👩🏿\u200d🐰\u200d👩🏽":  2509,
+	"👩🏿\u200d🐰\u200d👩🏾":  2510,
+	"👯🏿\u200d♀️":         2511,
+	"👯🏿\u200d♀":          2512,
+	"🧖":                  2513,
+	"🧖🏻":                 2514,
+	"🧖🏼":                 2515,
+	"🧖🏽":                 2516,
+	"🧖🏾":                 2517,
+	"🧖🏿":                 2518,
+	"🧖\u200d♂️":          2519,
+	"🧖\u200d♂":           2520,
+	"🧖🏻\u200d♂️":         2521,
+	"🧖🏻\u200d♂":          2522,
+	"🧖🏼\u200d♂️":         2523,
+	"🧖🏼\u200d♂":          2524,
+	"🧖🏽\u200d♂️":         2525,
+	"🧖🏽\u200d♂":          2526,
+	"🧖🏾\u200d♂️":         2527,
+	"🧖🏾\u200d♂":          2528,
+	"🧖🏿\u200d♂️":         2529,
+	"🧖🏿\u200d♂":          2530,
+	"🧖\u200d♀️":          2531,
+	"🧖\u200d♀":           2532,
+	"🧖🏻\u200d♀️":         2533,
+	"🧖🏻\u200d♀":          2534,
+	"🧖🏼\u200d♀️":         2535,
+	"🧖🏼\u200d♀":          2536,
+	"🧖🏽\u200d♀️":         2537,
+	"🧖🏽\u200d♀":          2538,
+	"🧖🏾\u200d♀️":         2539,
+	"🧖🏾\u200d♀":          2540,
+	"🧖🏿\u200d♀️":         2541,
+	"🧖🏿\u200d♀":          2542,
+	"🧗":                  2543,
+	"🧗🏻":                 2544,
+	"🧗🏼":                 2545,
+	"🧗🏽":                 2546,
+	"🧗🏾":                 2547,
+	"🧗🏿":                 2548,
+	"🧗\u200d♂️":          2549,
+	"🧗\u200d♂":           2550,
+	"🧗🏻\u200d♂️":         2551,
+	"🧗🏻\u200d♂":          2552,
+	"🧗🏼\u200d♂️":         2553,
+	"🧗🏼\u200d♂":          2554,
+	"🧗🏽\u200d♂️":         2555,
+	"🧗🏽\u200d♂":          2556,
+	"🧗🏾\u200d♂️":         2557,
+	"🧗🏾\u200d♂":          2558,
+	"🧗🏿\u200d♂️":         2559,
+	"🧗🏿\u200d♂":          2560,
+	"🧗\u200d♀️":          2561,
+	"🧗\u200d♀":           2562,
+	"🧗🏻\u200d♀️":         2563,
+	"🧗🏻\u200d♀":          2564,
+	"🧗🏼\u200d♀️":         2565,
+	"🧗🏼\u200d♀":          2566,
+	"🧗🏽\u200d♀️":         2567,
+	"🧗🏽\u200d♀":          2568,
+	"🧗🏾\u200d♀️":         2569,
+	"🧗🏾\u200d♀":          2570,
+	"🧗🏿\u200d♀️":         2571,
+	"🧗🏿\u200d♀":          2572,
+	"🧑\u200d🩰":           2573,
+	"🧑🏻\u200d🩰":          2574,
+	"🧑🏼\u200d🩰":          2575,
+	"🧑🏽\u200d🩰":          2576,
+	"🧑🏾\u200d🩰":          2577,
+	"🧑🏿\u200d🩰":          2578,
+	"🤺":                  2579,
+	"🏇":                  2580,
+	"🏇🏻":                 2581,
+	"🏇🏼":                 2582,
+	"🏇🏽":                 2583,
+	"🏇🏾":                 2584,
+	"🏇🏿":                 2585,
+	"⛷️":                 2586,
+	"⛷":                  2587,
+	"🏂":                  2588,
+	"🏂🏻":                 2589,
+	"🏂🏼":                 2590,
+	"🏂🏽":                 2591,
+	"🏂🏾":                 2592,
+	"🏂🏿":                 2593,
+	"🏌️":                 2594,
+	"🏌":                  2595,
+	"🏌🏻":                 2596,
+	"🏌🏼":                 2597,
+	"🏌🏽":                 2598,
+	"🏌🏾":                 2599,
+	"🏌🏿":                 2600,
+	"🏌️\u200d♂️":         2601,
+	"🏌\u200d♂️":          2602,
+	"🏌️\u200d♂":          2603,
+	"🏌\u200d♂":           2604,
+	"🏌🏻\u200d♂️":         2605,
+	"🏌🏻\u200d♂":          2606,
+	"🏌🏼\u200d♂️":         2607,
+	"🏌🏼\u200d♂":          2608,
+	"🏌🏽\u200d♂️":         2609,
+	"🏌🏽\u200d♂":          2610,
+	"🏌🏾\u200d♂️":         2611,
+	"🏌🏾\u200d♂":          2612,
+	"🏌🏿\u200d♂️":         2613,
+	"🏌🏿\u200d♂":          2614,
+	"🏌️\u200d♀️":         2615,
+	"🏌\u200d♀️":          2616,
+	"🏌️\u200d♀":          2617,
+	"🏌\u200d♀":           2618,
+	"🏌🏻\u200d♀️":         2619,
+	"🏌🏻\u200d♀":          2620,
+	"🏌🏼\u200d♀️":         2621,
+	"🏌🏼\u200d♀":          2622,
+	"🏌🏽\u200d♀️":         2623,
+	"🏌🏽\u200d♀":          2624,
+	"🏌🏾\u200d♀️":         2625,
+	"🏌🏾\u200d♀":          2626,
+	"🏌🏿\u200d♀️":         2627,
+	"🏌🏿\u200d♀":          2628,
+	"🏄":                  2629,
+	"🏄🏻":                 2630,
+	"🏄🏼":                 2631,
+	"🏄🏽":                 2632,
+	"🏄🏾":                 2633,
+	"🏄🏿":                 2634,
+	"🏄\u200d♂️":          2635,
+	"🏄\u200d♂":           2636,
+	"🏄🏻\u200d♂️":         2637,
+	"🏄🏻\u200d♂":          2638,
+	"🏄🏼\u200d♂️":         2639,
+	"🏄🏼\u200d♂":          2640,
+	"🏄🏽\u200d♂️":         2641,
+	"🏄🏽\u200d♂":          2642,
+	"🏄🏾\u200d♂️":         2643,
+	"🏄🏾\u200d♂":          2644,
+	"🏄🏿\u200d♂️":         2645,
+	"🏄🏿\u200d♂":          2646,
+	"🏄\u200d♀️":          2647,
+	"🏄\u200d♀":           2648,
+	"🏄🏻\u200d♀️":         2649,
+	"🏄🏻\u200d♀":          2650,
+	"🏄🏼\u200d♀️":         2651,
+	"🏄🏼\u200d♀":          2652,
+	"🏄🏽\u200d♀️":         2653,
+	"🏄🏽\u200d♀":          2654,
+	"🏄🏾\u200d♀️":         2655,
+	"🏄🏾\u200d♀":          2656,
+	"🏄🏿\u200d♀️":         2657,
+	"🏄🏿\u200d♀":          2658,
+	"🚣":                  2659,
+	"🚣🏻":                 2660,
+	"🚣🏼":                 2661,
+	"🚣🏽":                 2662,
+	"🚣🏾":                 2663,
+	"🚣🏿":                 2664,
+	"🚣\u200d♂️":          2665,
+	"🚣\u200d♂":           2666,
+	"🚣🏻\u200d♂️":         2667,
+	"🚣🏻\u200d♂":          2668,
+	"🚣🏼\u200d♂️":         2669,
+	"🚣🏼\u200d♂":          2670,
+	"🚣🏽\u200d♂️":         2671,
+	"🚣🏽\u200d♂":          2672,
+	"🚣🏾\u200d♂️":         2673,
+	"🚣🏾\u200d♂":          2674,
+	"🚣🏿\u200d♂️":         2675,
+	"🚣🏿\u200d♂":          2676,
+	"🚣\u200d♀️":          2677,
+	"🚣\u200d♀":           2678,
+	"🚣🏻\u200d♀️":         2679,
+	"🚣🏻\u200d♀":          2680,
+	"🚣🏼\u200d♀️":         2681,
+	"🚣🏼\u200d♀":          2682,
+	"🚣🏽\u200d♀️":         2683,
+	"🚣🏽\u200d♀":          2684,
+	"🚣🏾\u200d♀️":         2685,
+	"🚣🏾\u200d♀":          2686,
+	"🚣🏿\u200d♀️":         2687,
+	"🚣🏿\u200d♀":          2688,
+	"🏊":                  2689,
+	"🏊🏻":                 2690,
+	"🏊🏼":                 2691,
+	"🏊🏽":                 2692,
+	"🏊🏾":                 2693,
+	"🏊🏿":                 2694,
+	"🏊\u200d♂️":          2695,
+	"🏊\u200d♂":           2696,
+	"🏊🏻\u200d♂️":         2697,
+	"🏊🏻\u200d♂":          2698,
+	"🏊🏼\u200d♂️":         2699,
+	"🏊🏼\u200d♂":          2700,
+	"🏊🏽\u200d♂️":         2701,
+	"🏊🏽\u200d♂":          2702,
+	"🏊🏾\u200d♂️":         2703,
+	"🏊🏾\u200d♂":          2704,
+	"🏊🏿\u200d♂️":         2705,
+	"🏊🏿\u200d♂":          2706,
+	"🏊\u200d♀️":          2707,
+	"🏊\u200d♀":           2708,
+	"🏊🏻\u200d♀️":         2709,
+	"🏊🏻\u200d♀":          2710,
+	"🏊🏼\u200d♀️":         2711,
+	"🏊🏼\u200d♀":          2712,
+	"🏊🏽\u200d♀️":         2713,
+	"🏊🏽\u200d♀":          2714,
+	"🏊🏾\u200d♀️":         2715,
+	"🏊🏾\u200d♀":          2716,
+	"🏊🏿\u200d♀️":         2717,
+	"🏊🏿\u200d♀":          2718,
+	"⛹️":                 2719,
+	"⛹":                  2720,
+	"⛹🏻":                 2721,
+	"⛹🏼":                 2722,
+	"⛹🏽":                 2723,
+	"⛹🏾":                 2724,
+	"⛹🏿":                 2725,
+	"⛹️\u200d♂️":         2726,
+	"⛹\u200d♂️":          2727,
+	"⛹️\u200d♂":          2728,
+	"⛹\u200d♂":           2729,
+	"⛹🏻\u200d♂️":         2730,
+	"⛹🏻\u200d♂":          2731,
+	"⛹🏼\u200d♂️":         2732,
+	"⛹🏼\u200d♂":          2733,
+	"⛹🏽\u200d♂️":         2734,
+	"⛹🏽\u200d♂":          2735,
+	"⛹🏾\u200d♂️":         2736,
+	"⛹🏾\u200d♂":          2737,
+	"⛹🏿\u200d♂️":         2738,
+	"⛹🏿\u200d♂":          2739,
+	"⛹️\u200d♀️":         2740,
+	"⛹\u200d♀️":          2741,
+	"⛹️\u200d♀":          2742,
+	"⛹\u200d♀":           2743,
+	"⛹🏻\u200d♀️":         2744,
+	"⛹🏻\u200d♀":          2745,
+	"⛹🏼\u200d♀️":         2746,
+	"⛹🏼\u200d♀":          2747,
+	"⛹🏽\u200d♀️":         2748,
+	"⛹🏽\u200d♀":          2749,
+	"⛹🏾\u200d♀️":         2750,
+	"⛹🏾\u200d♀":          2751,
+	"⛹🏿\u200d♀️":         2752,
+	"⛹🏿\u200d♀":          2753,
+	"🏋️":                 2754,
+	"🏋":                  2755,
+	"🏋🏻":                 2756,
+	"🏋🏼":                 2757,
+	"🏋🏽":                 2758,
+	"🏋🏾":                 2759,
+	"🏋🏿":                 2760,
+	"🏋️\u200d♂️":         2761,
+	"🏋\u200d♂️":          2762,
+	"🏋️\u200d♂":          2763,
+	"🏋\u200d♂":           2764,
+	"🏋🏻\u200d♂️":         2765,
+	"🏋🏻\u200d♂":          2766,
+	"🏋🏼\u200d♂️":         2767,
+	"🏋🏼\u200d♂":          2768,
+	"🏋🏽\u200d♂️":         2769,
+	"🏋🏽\u200d♂":          2770,
+	"🏋🏾\u200d♂️":         2771,
+	"🏋🏾\u200d♂":          2772,
+	"🏋🏿\u200d♂️":         2773,
+	"🏋🏿\u200d♂":          2774,
+	"🏋️\u200d♀️":         2775,
+	"🏋\u200d♀️":          2776,
+	"🏋️\u200d♀":          2777,
+	"🏋\u200d♀":           2778,
+	"🏋🏻\u200d♀️":         2779,
+	"🏋🏻\u200d♀":          2780,
+	"🏋🏼\u200d♀️":         2781,
+	"🏋🏼\u200d♀":          2782,
+	"🏋🏽\u200d♀️":         2783,
+	"🏋🏽\u200d♀":          2784,
+	"🏋🏾\u200d♀️":         2785,
+	"🏋🏾\u200d♀":          2786,
+	"🏋🏿\u200d♀️":         2787,
+	"🏋🏿\u200d♀":          2788,
+	"🚴":                  2789,
+	"🚴🏻":                 2790,
+	"🚴🏼":                 2791,
+	"🚴🏽":                 2792,
+	"🚴🏾":                 2793,
+	"🚴🏿":                 2794,
+	"🚴\u200d♂️":          2795,
+	"🚴\u200d♂":           2796,
+	"🚴🏻\u200d♂️":         2797,
+	"🚴🏻\u200d♂":          2798,
+	"🚴🏼\u200d♂️":         2799,
+	"🚴🏼\u200d♂":          2800,
+	"🚴🏽\u200d♂️":         2801,
+	"🚴🏽\u200d♂":          2802,
+	"🚴🏾\u200d♂️":         2803,
+	"🚴🏾\u200d♂":          2804,
+	"🚴🏿\u200d♂️":         2805,
+	"🚴🏿\u200d♂":          2806,
+	"🚴\u200d♀️":          2807,
+	"🚴\u200d♀":           2808,
+	"🚴🏻\u200d♀️":         2809,
+	"🚴🏻\u200d♀":          2810,
+	"🚴🏼\u200d♀️":         2811,
+	"🚴🏼\u200d♀":          2812,
+	"🚴🏽\u200d♀️":         2813,
+	"🚴🏽\u200d♀":          2814,
+	"🚴🏾\u200d♀️":         2815,
+	"🚴🏾\u200d♀":          2816,
+	"🚴🏿\u200d♀️":         2817,
+	"🚴🏿\u200d♀":          2818,
+	"🚵":                  2819,
+	"🚵🏻":                 2820,
+	"🚵🏼":                 2821,
+	"🚵🏽":                 2822,
+	"🚵🏾":                 2823,
+	"🚵🏿":                 2824,
+	"🚵\u200d♂️":          2825,
+	"🚵\u200d♂":           2826,
+	"🚵🏻\u200d♂️":         2827,
+	"🚵🏻\u200d♂":          2828,
+	"🚵🏼\u200d♂️":         2829,
+	"🚵🏼\u200d♂":          2830,
+	"🚵🏽\u200d♂️":         2831,
+	"🚵🏽\u200d♂":          2832,
+	"🚵🏾\u200d♂️":         2833,
+	"🚵🏾\u200d♂":          2834,
+	"🚵🏿\u200d♂️":         2835,
+	"🚵🏿\u200d♂":          2836,
+	"🚵\u200d♀️":          2837,
+	"🚵\u200d♀":           2838,
+	"🚵🏻\u200d♀️":         2839,
+	"🚵🏻\u200d♀":          2840,
+	"🚵🏼\u200d♀️":         2841,
+	"🚵🏼\u200d♀":          2842,
+	"🚵🏽\u200d♀️":         2843,
+	"🚵🏽\u200d♀":          2844,
+	"🚵🏾\u200d♀️":         2845,
+	"🚵🏾\u200d♀":          2846,
+	"🚵🏿\u200d♀️":         2847,
+	"🚵🏿\u200d♀":          2848,
+	"🤸":                  2849,
+	"🤸🏻":                 2850,
+	"🤸🏼":                 2851,
+	"🤸🏽":                 2852,
+	"🤸🏾":                 2853,
+	"🤸🏿":                 2854,
+	"🤸\u200d♂️":          2855,
+	"🤸\u200d♂":           2856,
+	"🤸🏻\u200d♂️":         2857,
+	"🤸🏻\u200d♂":          2858,
+	"🤸🏼\u200d♂️":         2859,
+	"🤸🏼\u200d♂":          2860,
+	"🤸🏽\u200d♂️":         2861,
+	"🤸🏽\u200d♂":          2862,
+	"🤸🏾\u200d♂️":         2863,
+	"🤸🏾\u200d♂":          2864,
+	"🤸🏿\u200d♂️":         2865,
+	"🤸🏿\u200d♂":          2866,
+	"🤸\u200d♀️":          2867,
+	"🤸\u200d♀":           2868,
+	"🤸🏻\u200d♀️":         2869,
+	"🤸🏻\u200d♀":          2870,
+	"🤸🏼\u200d♀️":         2871,
+	"🤸🏼\u200d♀":          2872,
+	"🤸🏽\u200d♀️":         2873,
+	"🤸🏽\u200d♀":          2874,
+	"🤸🏾\u200d♀️":         2875,
+	"🤸🏾\u200d♀":          2876,
+	"🤸🏿\u200d♀️":         2877,
+	"🤸🏿\u200d♀":          2878,
+	"🤼":                  2879,
+	"🧑🏻\u200d🫯\u200d🧑🏼":  2880,
+	"🧑🏻\u200d🫯\u200d🧑🏽":  2881,
+	"🧑🏻\u200d🫯\u200d🧑🏾":  2882,
+	"🧑🏻\u200d🫯\u200d🧑🏿":  2883,
+	"🧑🏼\u200d🫯\u200d🧑🏻":  2884,
+	"🧑🏼\u200d🫯\u200d🧑🏽":  2885,
+	"🧑🏼\u200d🫯\u200d🧑🏾":  2886,
+	"🧑🏼\u200d🫯\u200d🧑🏿":  2887,
+	"🧑🏽\u200d🫯\u200d🧑🏻":  2888,
+	"🧑🏽\u200d🫯\u200d🧑🏼":  2889,
+	"🧑🏽\u200d🫯\u200d🧑🏾":  2890,
+	"🧑🏽\u200d🫯\u200d🧑🏿":  2891,
+	"🧑🏾\u200d🫯\u200d🧑🏻":  2892,
+	"🧑🏾\u200d🫯\u200d🧑🏼":  2893,
+	"🧑🏾\u200d🫯\u200d🧑🏽":  2894,
+	"🧑🏾\u200d🫯\u200d🧑🏿":  2895,
+	"🧑🏿\u200d🫯\u200d🧑🏻":  2896,
+	"🧑🏿\u200d🫯\u200d🧑🏼":  2897,
+	"🧑🏿\u200d🫯\u200d🧑🏽":  2898,
+	"🧑🏿\u200d🫯\u200d🧑🏾":  2899,
+	"🤼\u200d♂️":          2900,
+	"🤼\u200d♂":           2901,
+	"🤼🏻\u200d♂️":         2902,
+	"🤼🏻\u200d♂":          2903,
+	"👨🏻\u200d🫯\u200d👨🏼":  2904,
+	"👨🏻\u200d🫯\u200d👨🏽":  2905,
+	"👨🏻\u200d🫯\u200d👨🏾":  2906,
+	"👨🏻\u200d🫯\u200d👨🏿":  2907,
+	"👨🏼\u200d🫯\u200d👨🏻":  2908,
+	"🤼🏼\u200d♂️":         2909,
+	"🤼🏼\u200d♂":          2910,
+	"👨🏼\u200d🫯\u200d👨🏽":  2911,
+	"👨🏼\u200d🫯\u200d👨🏾":  2912,
+	"👨🏼\u200d🫯\u200d👨🏿":  2913,
+	"👨🏽\u200d🫯\u200d👨🏻":  2914,
+	"👨🏽\u200d🫯\u200d👨🏼":  2915,
+	"🤼🏽\u200d♂️":         2916,
+	"🤼🏽\u200d♂":          2917,
+	"👨🏽\u200d🫯\u200d👨🏾":  2918,
+	"👨🏽\u200d🫯\u200d👨🏿":  2919,
+	"👨🏾\u200d🫯\u200d👨🏻":  2920,
+	"👨🏾\u200d🫯\u200d👨🏼":  2921,
+	"👨🏾\u200d🫯\u200d👨🏽":  2922,
+	"🤼🏾\u200d♂️":         2923,
+	"🤼🏾\u200d♂":          2924,
+	"👨🏾\u200d🫯\u200d👨🏿":  2925,
+	"👨🏿\u200d🫯\u200d👨🏻":  2926,
+	"👨🏿\u200d🫯\u200d👨🏼":  2927,
+	"👨🏿\u200d🫯\u200d👨🏽":  2928,
+	"👨🏿\u200d🫯\u200d👨🏾":  2929,
+	"🤼🏿\u200d♂️":         2930,
+	"🤼🏿\u200d♂":          2931,
+	"🤼\u200d♀️":          2932,
+	"🤼\u200d♀":           2933,
+	"🤼🏻\u200d♀️":         2934,
+	"🤼🏻\u200d♀":          2935,
+	"👩🏻\u200d🫯\u200d👩🏼":  2936,
+	"👩🏻\u200d🫯\u200d👩🏽":  2937,
+	"👩🏻\u200d🫯\u200d👩🏾":  2938,
+	"👩🏻\u200d🫯\u200d👩🏿":  2939,
+	"👩🏼\u200d🫯\u200d👩🏻":  2940,
+	"🤼🏼\u200d♀️":         2941,
+	"🤼🏼\u200d♀":          2942,
+	"👩🏼\u200d🫯\u200d👩🏽":  2943,
+	"👩🏼\u200d🫯\u200d👩🏾":  2944,
+	"👩🏼\u200d🫯\u200d👩🏿":  2945,
+	"👩🏽\u200d🫯\u200d👩🏻":  2946,
+	"👩🏽\u200d🫯\u200d👩🏼":  2947,
+	"🤼🏽\u200d♀️":         2948,
+	"🤼🏽\u200d♀":          2949,
+	"👩🏽\u200d🫯\u200d👩🏾":  2950,
+	"👩🏽\u200d🫯\u200d👩🏿":  2951,
+	"👩🏾\u200d🫯\u200d👩🏻":  2952,
+	"👩🏾\u200d🫯\u200d👩🏼":  2953,
+	"👩🏾\u200d🫯\u200d👩🏽":  2954,
+	"🤼🏾\u200d♀️":         2955,
+	"🤼🏾\u200d♀":          2956,
+	"👩🏾\u200d🫯\u200d👩🏿":  2957,
+	"👩🏿\u200d🫯\u200d👩🏻":  2958,
+	"👩🏿\u200d🫯\u200d👩🏼":  2959,
+	"👩🏿\u200d🫯\u200d👩🏽":  2960,
+	"👩🏿\u200d🫯\u200d👩🏾":  2961,
+	"🤼🏿\u200d♀️":         2962,
+	"🤼🏿\u200d♀":          2963,
+	"🤽":                  2964,
+	"🤽🏻":                 2965,
+	"🤽🏼":                 2966,
+	"🤽🏽":                 2967,
+	"🤽🏾":                 2968,
+	"🤽🏿":                 2969,
+	"🤽\u200d♂️":          2970,
+	"🤽\u200d♂":           2971,
+	"🤽🏻\u200d♂️":         2972,
+	"🤽🏻\u200d♂":          2973,
+	"🤽🏼\u200d♂️":         2974,
+	"🤽🏼\u200d♂":          2975,
+	"🤽🏽\u200d♂️":         2976,
+	"🤽🏽\u200d♂":          2977,
+	"🤽🏾\u200d♂️":         2978,
+	"🤽🏾\u200d♂":          2979,
+	"🤽🏿\u200d♂️":         2980,
+	"🤽🏿\u200d♂":          2981,
+	"🤽\u200d♀️":          2982,
+	"🤽\u200d♀":           2983,
+	"🤽🏻\u200d♀️":         2984,
+	"🤽🏻\u200d♀":          2985,
+	"🤽🏼\u200d♀️":         2986,
+	"🤽🏼\u200d♀":          2987,
+	"🤽🏽\u200d♀️":         2988,
+	"🤽🏽\u200d♀":          2989,
+	"🤽🏾\u200d♀️":         2990,
+	"🤽🏾\u200d♀":          2991,
+	"🤽🏿\u200d♀️":         2992,
+	"🤽🏿\u200d♀":          2993,
+	"🤾":                  2994,
+	"🤾🏻":                 2995,
+	"🤾🏼":                 2996,
+	"🤾🏽":                 2997,
+	"🤾🏾":                 2998,
+	"🤾🏿":                 2999,
+	"🤾\u200d♂️":          3000,
+	"🤾\u200d♂":           3001,
+	"🤾🏻\u200d♂️":         3002,
+	"🤾🏻\u200d♂":          3003,
+	"🤾🏼\u200d♂️":         3004,
+	"🤾🏼\u200d♂":          3005,
+	"🤾🏽\u200d♂️":         3006,
+	"🤾🏽\u200d♂":          3007,
+	"🤾🏾\u200d♂️":         3008,
+	"🤾🏾\u200d♂":          3009,
+	"🤾🏿\u200d♂️":         3010,
+	"🤾🏿\u200d♂":          3011,
+	"🤾\u200d♀️":          3012,
+	"🤾\u200d♀":           3013,
+	"🤾🏻\u200d♀️":         3014,
+	"🤾🏻\u200d♀":          3015,
+	"🤾🏼\u200d♀️":         3016,
+	"🤾🏼\u200d♀":          3017,
+	"🤾🏽\u200d♀️":         3018,
+	"🤾🏽\u200d♀":          3019,
+	"🤾🏾\u200d♀️":         3020,
+	"🤾🏾\u200d♀":          3021,
+	"🤾🏿\u200d♀️":         3022,
+	"🤾🏿\u200d♀":          3023,
+	"🤹":                  3024,
+	"🤹🏻":                 3025,
+	"🤹🏼":                 3026,
+	"🤹🏽":                 3027,
+	"🤹🏾":                 3028,
+	"🤹🏿":                 3029,
+	"🤹\u200d♂️":          3030,
+	"🤹\u200d♂":           3031,
+	"🤹🏻\u200d♂️":         3032,
+	"🤹🏻\u200d♂":          3033,
+	"🤹🏼\u200d♂️":         3034,
+	"🤹🏼\u200d♂":          3035,
+	"🤹🏽\u200d♂️":         3036,
+	"🤹🏽\u200d♂":          3037,
+	"🤹🏾\u200d♂️":         3038,
+	"🤹🏾\u200d♂":          3039,
+	"🤹🏿\u200d♂️":         3040,
+	"🤹🏿\u200d♂":          3041,
+	"🤹\u200d♀️":          3042,
+	"🤹\u200d♀":           3043,
+	"🤹🏻\u200d♀️":         3044,
+	"🤹🏻\u200d♀":          3045,
+	"🤹🏼\u200d♀️":         3046,
+	"🤹🏼\u200d♀":          3047,
+	"🤹🏽\u200d♀️":         3048,
+	"🤹🏽\u200d♀":          3049,
+	"🤹🏾\u200d♀️":         3050,
+	"🤹🏾\u200d♀":          3051,
+	"🤹🏿\u200d♀️":         3052,
+	"🤹🏿\u200d♀":          3053,
+	"🧘":                  3054,
+	"🧘🏻":                 3055,
+	"🧘🏼":                 3056,
+	"🧘🏽":                 3057,
+	"🧘🏾":                 3058,
+	"🧘🏿":                 3059,
+	"🧘\u200d♂️":          3060,
+	"🧘\u200d♂":           3061,
+	"🧘🏻\u200d♂️":         3062,
+	"🧘🏻\u200d♂":          3063,
+	"🧘🏼\u200d♂️":         3064,
+	"🧘🏼\u200d♂":          3065,
+	"🧘🏽\u200d♂️":         3066,
+	"🧘🏽\u200d♂":          3067,
+	"🧘🏾\u200d♂️":         3068,
+	"🧘🏾\u200d♂":          3069,
+	"🧘🏿\u200d♂️":         3070,
+	"🧘🏿\u200d♂":          3071,
+	"🧘\u200d♀️":          3072,
+	"🧘\u200d♀":           3073,
+	"🧘🏻\u200d♀️":         3074,
+	"🧘🏻\u200d♀":          3075,
+	"🧘🏼\u200d♀️":         3076,
+	"🧘🏼\u200d♀":          3077,
+	"🧘🏽\u200d♀️":         3078,
+	"🧘🏽\u200d♀":          3079,
+	"🧘🏾\u200d♀️":         3080,
+	"🧘🏾\u200d♀":          3081,
+	"🧘🏿\u200d♀️":         3082,
+	"🧘🏿\u200d♀":          3083,
+	"🛀":                  3084,
+	"🛀🏻":                 3085,
+	"🛀🏼":                 3086,
+	"🛀🏽":                 3087,
+	"🛀🏾":                 3088,
+	"🛀🏿":                 3089,
+	"🛌":                  3090,
+	"🛌🏻":                 3091,
+	"🛌🏼":                 3092,
+	"🛌🏽":                 3093,
+	"🛌🏾":                 3094,
+	"🛌🏿":                 3095,
+	"🧑\u200d🤝\u200d🧑":    3096,
+	"🧑🏻\u200d🤝\u200d🧑🏻":  3097,
+	"🧑🏻\u200d🤝\u200d🧑🏼":  3098,
+	"🧑🏻\u200d🤝\u200d🧑🏽":  3099,
+	"🧑🏻\u200d🤝\u200d🧑🏾":  3100,
+	"🧑🏻\u200d🤝\u200d🧑🏿":  3101,
+	"🧑🏼\u200d🤝\u200d🧑🏻":  3102,
+	"🧑🏼\u200d🤝\u200d🧑🏼":  3103,
+	"🧑🏼\u200d🤝\u200d🧑🏽":  3104,
+	"🧑🏼\u200d🤝\u200d🧑🏾":  3105,
+	"🧑🏼\u200d🤝\u200d🧑🏿":  3106,
+	"🧑🏽\u200d🤝\u200d🧑🏻":  3107,
+	"🧑🏽\u200d🤝\u200d🧑🏼":  3108,
+	"🧑🏽\u200d🤝\u200d🧑🏽":  3109,
+	"🧑🏽\u200d🤝\u200d🧑🏾":  3110,
+	"🧑🏽\u200d🤝\u200d🧑🏿":  3111,
+	"🧑🏾\u200d🤝\u200d🧑🏻":  3112,
+	"🧑🏾\u200d🤝\u200d🧑🏼":  3113,
+	"🧑🏾\u200d🤝\u200d🧑🏽":  3114,
+	"🧑🏾\u200d🤝\u200d🧑🏾":  3115,
+	"🧑🏾\u200d🤝\u200d🧑🏿":  3116,
+	"🧑🏿\u200d🤝\u200d🧑🏻":  3117,
+	"🧑🏿\u200d🤝\u200d🧑🏼":  3118,
+	"🧑🏿\u200d🤝\u200d🧑🏽":  3119,
+	"🧑🏿\u200d🤝\u200d🧑🏾":  3120,
+	"🧑🏿\u200d🤝\u200d🧑🏿":  3121,
+	"👭":                  3122,
+	"👭🏻":                 3123,
+	"👩🏻\u200d🤝\u200d👩🏼":  3124,
+	"👩🏻\u200d🤝\u200d👩🏽":  3125,
+	"👩🏻\u200d🤝\u200d👩🏾":  3126,
+	"👩🏻\u200d🤝\u200d👩🏿":  3127,
+	"👩🏼\u200d🤝\u200d👩🏻":  3128,
+	"👭🏼":                 3129,
+	"👩🏼\u200d🤝\u200d👩🏽":  3130,
+	"👩🏼\u200d🤝\u200d👩🏾":  3131,
+	"👩🏼\u200d🤝\u200d👩🏿":  3132,
+	"👩🏽\u200d🤝\u200d👩🏻":  3133,
+	"👩🏽\u200d🤝\u200d👩🏼":  3134,
+	"👭🏽":                 3135,
+	"👩🏽\u200d🤝\u200d👩🏾":  3136,
+	"👩🏽\u200d🤝\u200d👩🏿":  3137,
+	"👩🏾\u200d🤝\u200d👩🏻":  3138,
+	"👩🏾\u200d🤝\u200d👩🏼":  3139,
+	"👩🏾\u200d🤝\u200d👩🏽":  3140,
+	"👭🏾":                 3141,
+	"👩🏾\u200d🤝\u200d👩🏿":  3142,
+	"👩🏿\u200d🤝\u200d👩🏻":  3143,
+	"👩🏿\u200d🤝\u200d👩🏼":  3144,
+	"👩🏿\u200d🤝\u200d👩🏽":  3145,
+	"👩🏿\u200d🤝\u200d👩🏾":  3146,
+	"👭🏿":                 3147,
+	"👫":                  3148,
+	"👫🏻":                 3149,
+	"👩🏻\u200d🤝\u200d👨🏼":  3150,
+	"👩🏻\u200d🤝\u200d👨🏽":  3151,
+	"👩🏻\u200d🤝\u200d👨🏾":  3152,
+	"👩🏻\u200d🤝\u200d👨🏿":  3153,
+	"👩🏼\u200d🤝\u200d👨🏻":  3154,
+	"👫🏼":                 3155,
+	"👩🏼\u200d🤝\u200d👨🏽":  3156,
+	"👩🏼\u200d🤝\u200d👨🏾":  3157,
+	"👩🏼\u200d🤝\u200d👨🏿":  3158,
+	"👩🏽\u200d🤝\u200d👨🏻":  3159,
+	"👩🏽\u200d🤝\u200d👨🏼":  3160,
+	"👫🏽":                 3161,
+	"👩🏽\u200d🤝\u200d👨🏾":  3162,
+	"👩🏽\u200d🤝\u200d👨🏿":  3163,
+	"👩🏾\u200d🤝\u200d👨🏻":  3164,
+	"👩🏾\u200d🤝\u200d👨🏼":  3165,
+	"👩🏾\u200d🤝\u200d👨🏽":  3166,
+	"👫🏾":                 3167,
+	"👩🏾\u200d🤝\u200d👨🏿":  3168,
+	"👩🏿\u200d🤝\u200d👨🏻":  3169,
+	"👩🏿\u200d🤝\u200d👨🏼":  3170,
+	"👩🏿\u200d🤝\u200d👨🏽":  3171,
+	"👩🏿\u200d🤝\u200d👨🏾":  3172,
+	"👫🏿":                 3173,
+	"👬":                  3174,
+	"👬🏻":                 3175,
+	"👨🏻\u200d🤝\u200d👨🏼":  3176,
+	"👨🏻\u200d🤝\u200d👨🏽":  3177,
+	"👨🏻\u200d🤝\u200d👨🏾":  3178,
+	"👨🏻\u200d🤝\u200d👨🏿":  3179,
+	"👨🏼\u200d🤝\u200d👨🏻":  3180,
+	"👬🏼":                 3181,
+	"👨🏼\u200d🤝\u200d👨🏽":  3182,
+	"👨🏼\u200d🤝\u200d👨🏾":  3183,
+	"👨🏼\u200d🤝\u200d👨🏿":  3184,
+	"👨🏽\u200d🤝\u200d👨🏻":  3185,
+	"👨🏽\u200d🤝\u200d👨🏼":  3186,
+	"👬🏽":                 3187,
+	"👨🏽\u200d🤝\u200d👨🏾":  3188,
+	"👨🏽\u200d🤝\u200d👨🏿":  3189,
+	"👨🏾\u200d🤝\u200d👨🏻":  3190,
+	"👨🏾\u200d🤝\u200d👨🏼":  3191,
+	"👨🏾\u200d🤝\u200d👨🏽":  3192,
+	"👬🏾":                 3193,
+	"👨🏾\u200d🤝\u200d👨🏿":  3194,
+	"👨🏿\u200d🤝\u200d👨🏻":  3195,
+	"👨🏿\u200d🤝\u200d👨🏼":  3196,
+	"👨🏿\u200d🤝\u200d👨🏽":  3197,
+	"👨🏿\u200d🤝\u200d👨🏾":  3198,
+	"👬🏿":                 3199,
+	"💏":                  3200,
+	"💏🏻":                 3201,
+	"💏🏼":                 3202,
+	"💏🏽":                 3203,
+	"💏🏾":                 3204,
+	"💏🏿":                 3205,
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼": 3206,
+	"🧑🏻\u200d❤\u200d💋\u200d🧑🏼":  3207,
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽": 3208,
+	"🧑🏻\u200d❤\u200d💋\u200d🧑🏽":  3209,
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾": 3210,
+	"🧑🏻\u200d❤\u200d💋\u200d🧑🏾":  3211,
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿": 3212,
+	"🧑🏻\u200d❤\u200d💋\u200d🧑🏿":  3213,
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻": 3214,
+	"🧑🏼\u200d❤\u200d💋\u200d🧑🏻":  3215,
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽": 3216,
+	"🧑🏼\u200d❤\u200d💋\u200d🧑🏽":  3217,
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾": 3218,
+	"🧑🏼\u200d❤\u200d💋\u200d🧑🏾":  3219,
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿": 3220,
+	"🧑🏼\u200d❤\u200d💋\u200d🧑🏿":  3221,
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻": 3222,
+	"🧑🏽\u200d❤\u200d💋\u200d🧑🏻":  3223,
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼": 3224,
+	"🧑🏽\u200d❤\u200d💋\u200d🧑🏼":  3225,
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾": 3226,
+	"🧑🏽\u200d❤\u200d💋\u200d🧑🏾":  3227,
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿": 3228,
+	"🧑🏽\u200d❤\u200d💋\u200d🧑🏿":  3229,
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻": 3230,
+	"🧑🏾\u200d❤\u200d💋\u200d🧑🏻":  3231,
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼": 3232,
+	"🧑🏾\u200d❤\u200d💋\u200d🧑🏼":  3233,
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽": 3234,
+	"🧑🏾\u200d❤\u200d💋\u200d🧑🏽":  3235,
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿": 3236,
+	"🧑🏾\u200d❤\u200d💋\u200d🧑🏿":  3237,
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻": 3238,
+	"🧑🏿\u200d❤\u200d💋\u200d🧑🏻":  3239,
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼": 3240,
+	"🧑🏿\u200d❤\u200d💋\u200d🧑🏼":  3241,
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽": 3242,
+	"🧑🏿\u200d❤\u200d💋\u200d🧑🏽":  3243,
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾": 3244,
+	"🧑🏿\u200d❤\u200d💋\u200d🧑🏾":  3245,
+	"👩\u200d❤️\u200d💋\u200d👨":   3246,
+	"👩\u200d❤\u200d💋\u200d👨":    3247,
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏻": 3248,
+	"👩🏻\u200d❤\u200d💋\u200d👨🏻":  3249,
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏼": 3250,
+	"👩🏻\u200d❤\u200d💋\u200d👨🏼":  3251,
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏽": 3252,
+	"👩🏻\u200d❤\u200d💋\u200d👨🏽":  3253,
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏾": 3254,
+	"👩🏻\u200d❤\u200d💋\u200d👨🏾":  3255,
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏿": 3256,
+	"👩🏻\u200d❤\u200d💋\u200d👨🏿":  3257,
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏻": 3258,
+	"👩🏼\u200d❤\u200d💋\u200d👨🏻":  3259,
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏼": 3260,
+	"👩🏼\u200d❤\u200d💋\u200d👨🏼":  3261,
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏽": 3262,
+	"👩🏼\u200d❤\u200d💋\u200d👨🏽":  3263,
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏾": 3264,
+	"👩🏼\u200d❤\u200d💋\u200d👨🏾":  3265,
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏿": 3266,
+	"👩🏼\u200d❤\u200d💋\u200d👨🏿":  3267,
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏻": 3268,
+	"👩🏽\u200d❤\u200d💋\u200d👨🏻":  3269,
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏼": 3270,
+	"👩🏽\u200d❤\u200d💋\u200d👨🏼":  3271,
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏽": 3272,
+	"👩🏽\u200d❤\u200d💋\u200d👨🏽":  3273,
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏾": 3274,
+	"👩🏽\u200d❤\u200d💋\u200d👨🏾":  3275,
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏿": 3276,
+	"👩🏽\u200d❤\u200d💋\u200d👨🏿":  3277,
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏻": 3278,
+	"👩🏾\u200d❤\u200d💋\u200d👨🏻":  3279,
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏼": 3280,
+	"👩🏾\u200d❤\u200d💋\u200d👨🏼":  3281,
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏽": 3282,
+	"👩🏾\u200d❤\u200d💋\u200d👨🏽":  3283,
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏾": 3284,
+	"👩🏾\u200d❤\u200d💋\u200d👨🏾":  3285,
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏿": 3286,
+	"👩🏾\u200d❤\u200d💋\u200d👨🏿":  3287,
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏻": 3288,
+	"👩🏿\u200d❤\u200d💋\u200d👨🏻":  3289,
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏼": 3290,
+	"👩🏿\u200d❤\u200d💋\u200d👨🏼":  3291,
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏽": 3292,
+	"👩🏿\u200d❤\u200d💋\u200d👨🏽":  3293,
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏾": 3294,
+	"👩🏿\u200d❤\u200d💋\u200d👨🏾":  3295,
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏿": 3296,
+	"👩🏿\u200d❤\u200d💋\u200d👨🏿":  3297,
+	"👨\u200d❤️\u200d💋\u200d👨":   3298,
+	"👨\u200d❤\u200d💋\u200d👨":    3299,
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏻": 3300,
+	"👨🏻\u200d❤\u200d💋\u200d👨🏻":  3301,
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏼": 3302,
+	"👨🏻\u200d❤\u200d💋\u200d👨🏼":  3303,
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏽": 3304,
+	"👨🏻\u200d❤\u200d💋\u200d👨🏽":  3305,
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏾": 3306,
+	"👨🏻\u200d❤\u200d💋\u200d👨🏾":  3307,
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏿": 3308,
+	"👨🏻\u200d❤\u200d💋\u200d👨🏿":  3309,
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏻": 3310,
+	"👨🏼\u200d❤\u200d💋\u200d👨🏻":  3311,
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏼": 3312,
+	"👨🏼\u200d❤\u200d💋\u200d👨🏼":  3313,
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏽": 3314,
+	"👨🏼\u200d❤\u200d💋\u200d👨🏽":  3315,
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏾": 3316,
+	"👨🏼\u200d❤\u200d💋\u200d👨🏾":  3317,
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏿": 3318,
+	"👨🏼\u200d❤\u200d💋\u200d👨🏿":  3319,
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏻": 3320,
+	"👨🏽\u200d❤\u200d💋\u200d👨🏻":  3321,
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏼": 3322,
+	"👨🏽\u200d❤\u200d💋\u200d👨🏼":  3323,
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏽": 3324,
+	"👨🏽\u200d❤\u200d💋\u200d👨🏽":  3325,
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏾": 3326,
+	"👨🏽\u200d❤\u200d💋\u200d👨🏾":  3327,
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏿": 3328,
+	"👨🏽\u200d❤\u200d💋\u200d👨🏿":  3329,
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏻": 3330,
+	"👨🏾\u200d❤\u200d💋\u200d👨🏻":  3331,
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏼": 3332,
+	"👨🏾\u200d❤\u200d💋\u200d👨🏼":  3333,
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏽": 3334,
+	"👨🏾\u200d❤\u200d💋\u200d👨🏽":  3335,
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏾": 3336,
+	"👨🏾\u200d❤\u200d💋\u200d👨🏾":  3337,
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏿": 3338,
+	"👨🏾\u200d❤\u200d💋\u200d👨🏿":  3339,
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏻": 3340,
+	"👨🏿\u200d❤\u200d💋\u200d👨🏻":  3341,
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏼": 3342,
+	"👨🏿\u200d❤\u200d💋\u200d👨🏼":  3343,
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏽": 3344,
+	"👨🏿\u200d❤\u200d💋\u200d👨🏽":  3345,
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏾": 3346,
+	"👨🏿\u200d❤\u200d💋\u200d👨🏾":  3347,
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏿": 3348,
+	"👨🏿\u200d❤\u200d💋\u200d👨🏿":  3349,
+	"👩\u200d❤️\u200d💋\u200d👩":   3350,
+	"👩\u200d❤\u200d💋\u200d👩":    3351,
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏻": 3352,
+	"👩🏻\u200d❤\u200d💋\u200d👩🏻":  3353,
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏼": 3354,
+	"👩🏻\u200d❤\u200d💋\u200d👩🏼":  3355,
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏽": 3356,
+	"👩🏻\u200d❤\u200d💋\u200d👩🏽":  3357,
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏾": 3358,
+	"👩🏻\u200d❤\u200d💋\u200d👩🏾":  3359,
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏿": 3360,
+	"👩🏻\u200d❤\u200d💋\u200d👩🏿":  3361,
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏻": 3362,
+	"👩🏼\u200d❤\u200d💋\u200d👩🏻":  3363,
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏼": 3364,
+	"👩🏼\u200d❤\u200d💋\u200d👩🏼":  3365,
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏽": 3366,
+	"👩🏼\u200d❤\u200d💋\u200d👩🏽":  3367,
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏾": 3368,
+	"👩🏼\u200d❤\u200d💋\u200d👩🏾":  3369,
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏿": 3370,
+	"👩🏼\u200d❤\u200d💋\u200d👩🏿":  3371,
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏻": 3372,
+	"👩🏽\u200d❤\u200d💋\u200d👩🏻":  3373,
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏼": 3374,
+	"👩🏽\u200d❤\u200d💋\u200d👩🏼":  3375,
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏽": 3376,
+	"👩🏽\u200d❤\u200d💋\u200d👩🏽":  3377,
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏾": 3378,
+	"👩🏽\u200d❤\u200d💋\u200d👩🏾":  3379,
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏿": 3380,
+	"👩🏽\u200d❤\u200d💋\u200d👩🏿":  3381,
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏻": 3382,
+	"👩🏾\u200d❤\u200d💋\u200d👩🏻":  3383,
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏼": 3384,
+	"👩🏾\u200d❤\u200d💋\u200d👩🏼":  3385,
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏽": 3386,
+	"👩🏾\u200d❤\u200d💋\u200d👩🏽":  3387,
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏾": 3388,
+	"👩🏾\u200d❤\u200d💋\u200d👩🏾":  3389,
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏿": 3390,
+	"👩🏾\u200d❤\u200d💋\u200d👩🏿":  3391,
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏻": 3392,
+	"👩🏿\u200d❤\u200d💋\u200d👩🏻":  3393,
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏼": 3394,
+	"👩🏿\u200d❤\u200d💋\u200d👩🏼":  3395,
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏽": 3396,
+	"👩🏿\u200d❤\u200d💋\u200d👩🏽":  3397,
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏾": 3398,
+	"👩🏿\u200d❤\u200d💋\u200d👩🏾":  3399,
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏿": 3400,
+	"👩🏿\u200d❤\u200d💋\u200d👩🏿":  3401,
+	"💑":                      3402,
+	"💑🏻":                     3403,
+	"💑🏼":                     3404,
+	"💑🏽":                     3405,
+	"💑🏾":                     3406,
+	"💑🏿":                     3407,
+	"🧑🏻\u200d❤️\u200d🧑🏼":     3408,
+	"🧑🏻\u200d❤\u200d🧑🏼":      3409,
+	"🧑🏻\u200d❤️\u200d🧑🏽":     3410,
+	"🧑🏻\u200d❤\u200d🧑🏽":      3411,
+	"🧑🏻\u200d❤️\u200d🧑🏾":     3412,
+	"🧑🏻\u200d❤\u200d🧑🏾":      3413,
+	"🧑🏻\u200d❤️\u200d🧑🏿":     3414,
+	"🧑🏻\u200d❤\u200d🧑🏿":      3415,
+	"🧑🏼\u200d❤️\u200d🧑🏻":     3416,
+	"🧑🏼\u200d❤\u200d🧑🏻":      3417,
+	"🧑🏼\u200d❤️\u200d🧑🏽":     3418,
+	"🧑🏼\u200d❤\u200d🧑🏽":      3419,
+	"🧑🏼\u200d❤️\u200d🧑🏾":     3420,
+	"🧑🏼\u200d❤\u200d🧑🏾":      3421,
+	"🧑🏼\u200d❤️\u200d🧑🏿":     3422,
+	"🧑🏼\u200d❤\u200d🧑🏿":      3423,
+	"🧑🏽\u200d❤️\u200d🧑🏻":     3424,
+	"🧑🏽\u200d❤\u200d🧑🏻":      3425,
+	"🧑🏽\u200d❤️\u200d🧑🏼":     3426,
+	"🧑🏽\u200d❤\u200d🧑🏼":      3427,
+	"🧑🏽\u200d❤️\u200d🧑🏾":     3428,
+	"🧑🏽\u200d❤\u200d🧑🏾":      3429,
+	"🧑🏽\u200d❤️\u200d🧑🏿":     3430,
+	"🧑🏽\u200d❤\u200d🧑🏿":      3431,
+	"🧑🏾\u200d❤️\u200d🧑🏻":     3432,
+	"🧑🏾\u200d❤\u200d🧑🏻":      3433,
+	"🧑🏾\u200d❤️\u200d🧑🏼":     3434,
+	"🧑🏾\u200d❤\u200d🧑🏼":      3435,
+	"🧑🏾\u200d❤️\u200d🧑🏽":     3436,
+	"🧑🏾\u200d❤\u200d🧑🏽":      3437,
+	"🧑🏾\u200d❤️\u200d🧑🏿":     3438,
+	"🧑🏾\u200d❤\u200d🧑🏿":      3439,
+	"🧑🏿\u200d❤️\u200d🧑🏻":     3440,
+	"🧑🏿\u200d❤\u200d🧑🏻":      3441,
+	"🧑🏿\u200d❤️\u200d🧑🏼":     3442,
+	"🧑🏿\u200d❤\u200d🧑🏼":      3443,
+	"🧑🏿\u200d❤️\u200d🧑🏽":     3444,
+	"🧑🏿\u200d❤\u200d🧑🏽":      3445,
+	"🧑🏿\u200d❤️\u200d🧑🏾":     3446,
+	"🧑🏿\u200d❤\u200d🧑🏾":      3447,
+	"👩\u200d❤️\u200d👨":       3448,
+	"👩\u200d❤\u200d👨":        3449,
+	"👩🏻\u200d❤️\u200d👨🏻":     3450,
+	"👩🏻\u200d❤\u200d👨🏻":      3451,
+	"👩🏻\u200d❤️\u200d👨🏼":     3452,
+	"👩🏻\u200d❤\u200d👨🏼":      3453,
+	"👩🏻\u200d❤️\u200d👨🏽":     3454,
+	"👩🏻\u200d❤\u200d👨🏽":      3455,
+	"👩🏻\u200d❤️\u200d👨🏾":     3456,
+	"👩🏻\u200d❤\u200d👨🏾":      3457,
+	"👩🏻\u200d❤️\u200d👨🏿":     3458,
+	"👩🏻\u200d❤\u200d👨🏿":      3459,
+	"👩🏼\u200d❤️\u200d👨🏻":     3460,
+	"👩🏼\u200d❤\u200d👨🏻":      3461,
+	"👩🏼\u200d❤️\u200d👨🏼":     3462,
+	"👩🏼\u200d❤\u200d👨🏼":      3463,
+	"👩🏼\u200d❤️\u200d👨🏽":     3464,
+	"👩🏼\u200d❤\u200d👨🏽":      3465,
+	"👩🏼\u200d❤️\u200d👨🏾":     3466,
+	"👩🏼\u200d❤\u200d👨🏾":      3467,
+	"👩🏼\u200d❤️\u200d👨🏿":     3468,
+	"👩🏼\u200d❤\u200d👨🏿":      3469,
+	"👩🏽\u200d❤️\u200d👨🏻":     3470,
+	"👩🏽\u200d❤\u200d👨🏻":      3471,
+	"👩🏽\u200d❤️\u200d👨🏼":     3472,
+	"👩🏽\u200d❤\u200d👨🏼":      3473,
+	"👩🏽\u200d❤️\u200d👨🏽":     3474,
+	"👩🏽\u200d❤\u200d👨🏽":      3475,
+	"👩🏽\u200d❤️\u200d👨🏾":     3476,
+	"👩🏽\u200d❤\u200d👨🏾":      3477,
+	"👩🏽\u200d❤️\u200d👨🏿":     3478,
+	"👩🏽\u200d❤\u200d👨🏿":      3479,
+	"👩🏾\u200d❤️\u200d👨🏻":     3480,
+	"👩🏾\u200d❤\u200d👨🏻":      3481,
+	"👩🏾\u200d❤️\u200d👨🏼":     3482,
+	"👩🏾\u200d❤\u200d👨🏼":      3483,
+	"👩🏾\u200d❤️\u200d👨🏽":     3484,
+	"👩🏾\u200d❤\u200d👨🏽":      3485,
+	"👩🏾\u200d❤️\u200d👨🏾":     3486,
+	"👩🏾\u200d❤\u200d👨🏾":      3487,
+	"👩🏾\u200d❤️\u200d👨🏿":     3488,
+	"👩🏾\u200d❤\u200d👨🏿":      3489,
+	"👩🏿\u200d❤️\u200d👨🏻":     3490,
+	"👩🏿\u200d❤\u200d👨🏻":      3491,
+	"👩🏿\u200d❤️\u200d👨🏼":     3492,
+	"👩🏿\u200d❤\u200d👨🏼":      3493,
+	"👩🏿\u200d❤️\u200d👨🏽":     3494,
+	"👩🏿\u200d❤\u200d👨🏽":      3495,
+	"👩🏿\u200d❤️\u200d👨🏾":     3496,
+	"👩🏿\u200d❤\u200d👨🏾":      3497,
+	"👩🏿\u200d❤️\u200d👨🏿":     3498,
+	"👩🏿\u200d❤\u200d👨🏿":      3499,
+	"👨\u200d❤️\u200d👨":       3500,
+	"👨\u200d❤\u200d👨":        3501,
+	"👨🏻\u200d❤️\u200d👨🏻":     3502,
+	"👨🏻\u200d❤\u200d👨🏻":      3503,
+	"👨🏻\u200d❤️\u200d👨🏼":     3504,
+	"👨🏻\u200d❤\u200d👨🏼":      3505,
+	"👨🏻\u200d❤️\u200d👨🏽":     3506,
+	"👨🏻\u200d❤\u200d👨🏽":      3507,
+	"👨🏻\u200d❤️\u200d👨🏾":     3508,
+	"👨🏻\u200d❤\u200d👨🏾":      3509,
+	"👨🏻\u200d❤️\u200d👨🏿":     3510,
+	"👨🏻\u200d❤\u200d👨🏿":      3511,
+	"👨🏼\u200d❤️\u200d👨🏻":     3512,
+	"👨🏼\u200d❤\u200d👨🏻":      3513,
+	"👨🏼\u200d❤️\u200d👨🏼":     3514,
+	"👨🏼\u200d❤\u200d👨🏼":      3515,
+	"👨🏼\u200d❤️\u200d👨🏽":     3516,
+	"👨🏼\u200d❤\u200d👨🏽":      3517,
+	"👨🏼\u200d❤️\u200d👨🏾":     3518,
+	"👨🏼\u200d❤\u200d👨🏾":      3519,
+	"👨🏼\u200d❤️\u200d👨🏿":     3520,
+	"👨🏼\u200d❤\u200d👨🏿":      3521,
+	"👨🏽\u200d❤️\u200d👨🏻":     3522,
+	"👨🏽\u200d❤\u200d👨🏻":      3523,
+	"👨🏽\u200d❤️\u200d👨🏼":     3524,
+	"👨🏽\u200d❤\u200d👨🏼":      3525,
+	"👨🏽\u200d❤️\u200d👨🏽":     3526,
+	"👨🏽\u200d❤\u200d👨🏽":      3527,
+	"👨🏽\u200d❤️\u200d👨🏾":     3528,
+	"👨🏽\u200d❤\u200d👨🏾":      3529,
+	"👨🏽\u200d❤️\u200d👨🏿":     3530,
+	"👨🏽\u200d❤\u200d👨🏿":      3531,
+	"👨🏾\u200d❤️\u200d👨🏻":     3532,
+	"👨🏾\u200d❤\u200d👨🏻":      3533,
+	"👨🏾\u200d❤️\u200d👨🏼":     3534,
+	"👨🏾\u200d❤\u200d👨🏼":      3535,
+	"👨🏾\u200d❤️\u200d👨🏽":     3536,
+	"👨🏾\u200d❤\u200d👨🏽":      3537,
+	"👨🏾\u200d❤️\u200d👨🏾":     3538,
+	"👨🏾\u200d❤\u200d👨🏾":      3539,
+	"👨🏾\u200d❤️\u200d👨🏿":     3540,
+	"👨🏾\u200d❤\u200d👨🏿":      3541,
+	"👨🏿\u200d❤️\u200d👨🏻":     3542,
+	"👨🏿\u200d❤\u200d👨🏻":      3543,
+	"👨🏿\u200d❤️\u200d👨🏼":     3544,
+	"👨🏿\u200d❤\u200d👨🏼":      3545,
+	"👨🏿\u200d❤️\u200d👨🏽":     3546,
+	"👨🏿\u200d❤\u200d👨🏽":      3547,
+	"👨🏿\u200d❤️\u200d👨🏾":     3548,
+	"👨🏿\u200d❤\u200d👨🏾":      3549,
+	"👨🏿\u200d❤️\u200d👨🏿":     3550,
+	"👨🏿\u200d❤\u200d👨🏿":      3551,
+	"👩\u200d❤️\u200d👩":       3552,
+	"👩\u200d❤\u200d👩":        3553,
+	"👩🏻\u200d❤️\u200d👩🏻":     3554,
+	"👩🏻\u200d❤\u200d👩🏻":      3555,
+	"👩🏻\u200d❤️\u200d👩🏼":     3556,
+	"👩🏻\u200d❤\u200d👩🏼":      3557,
+	"👩🏻\u200d❤️\u200d👩🏽":     3558,
+	"👩🏻\u200d❤\u200d👩🏽":      3559,
+	"👩🏻\u200d❤️\u200d👩🏾":     3560,
+	"👩🏻\u200d❤\u200d👩🏾":      3561,
+	"👩🏻\u200d❤️\u200d👩🏿":     3562,
+	"👩🏻\u200d❤\u200d👩🏿":      3563,
+	"👩🏼\u200d❤️\u200d👩🏻":     3564,
+	"👩🏼\u200d❤\u200d👩🏻":      3565,
+	"👩🏼\u200d❤️\u200d👩🏼":     3566,
+	"👩🏼\u200d❤\u200d👩🏼":      3567,
+	"👩🏼\u200d❤️\u200d👩🏽":     3568,
+	"👩🏼\u200d❤\u200d👩🏽":      3569,
+	"👩🏼\u200d❤️\u200d👩🏾":     3570,
+	"👩🏼\u200d❤\u200d👩🏾":      3571,
+	"👩🏼\u200d❤️\u200d👩🏿":     3572,
+	"👩🏼\u200d❤\u200d👩🏿":      3573,
+	"👩🏽\u200d❤️\u200d👩🏻":     3574,
+	"👩🏽\u200d❤\u200d👩🏻":      3575,
+	"👩🏽\u200d❤️\u200d👩🏼":     3576,
+	"👩🏽\u200d❤\u200d👩🏼":      3577,
+	"👩🏽\u200d❤️\u200d👩🏽":     3578,
+	"👩🏽\u200d❤\u200d👩🏽":      3579,
+	"👩🏽\u200d❤️\u200d👩🏾":     3580,
+	"👩🏽\u200d❤\u200d👩🏾":      3581,
+	"👩🏽\u200d❤️\u200d👩🏿":     3582,
+	"👩🏽\u200d❤\u200d👩🏿":      3583,
+	"👩🏾\u200d❤️\u200d👩🏻":     3584,
+	"👩🏾\u200d❤\u200d👩🏻":      3585,
+	"👩🏾\u200d❤️\u200d👩🏼":     3586,
+	"👩🏾\u200d❤\u200d👩🏼":      3587,
+	"👩🏾\u200d❤️\u200d👩🏽":     3588,
+	"👩🏾\u200d❤\u200d👩🏽":      3589,
+	"👩🏾\u200d❤️\u200d👩🏾":     3590,
+	"👩🏾\u200d❤\u200d👩🏾":      3591,
+	"👩🏾\u200d❤️\u200d👩🏿":     3592,
+	"👩🏾\u200d❤\u200d👩🏿":      3593,
+	"👩🏿\u200d❤️\u200d👩🏻":     3594,
+	"👩🏿\u200d❤\u200d👩🏻":      3595,
+	"👩🏿\u200d❤️\u200d👩🏼":     3596,
+	"👩🏿\u200d❤\u200d👩🏼":      3597,
+	"👩🏿\u200d❤️\u200d👩🏽":     3598,
+	"👩🏿\u200d❤\u200d👩🏽":      3599,
+	"👩🏿\u200d❤️\u200d👩🏾":     3600,
+	"👩🏿\u200d❤\u200d👩🏾":      3601,
+	"👩🏿\u200d❤️\u200d👩🏿":     3602,
+	"👩🏿\u200d❤\u200d👩🏿":      3603,
+	"👨\u200d👩\u200d👦":        3604,
+	"👨\u200d👩\u200d👧":        3605,
+	"👨\u200d👩\u200d👧\u200d👦": 3606,
+	"👨\u200d👩\u200d👦\u200d👦": 3607,
+	"👨\u200d👩\u200d👧\u200d👧": 3608,
+	"👨\u200d👨\u200d👦":        3609,
+	"👨\u200d👨\u200d👧":        3610,
+	"👨\u200d👨\u200d👧\u200d👦": 3611,
+	"👨\u200d👨\u200d👦\u200d👦": 3612,
+	"👨\u200d👨\u200d👧\u200d👧": 3613,
+	"👩\u200d👩\u200d👦":        3614,
+	"👩\u200d👩\u200d👧":        3615,
+	"👩\u200d👩\u200d👧\u200d👦": 3616,
+	"👩\u200d👩\u200d👦\u200d👦": 3617,
+	"👩\u200d👩\u200d👧\u200d👧": 3618,
+	"👨\u200d👦":               3619,
+	"👨\u200d👦\u200d👦":        3620,
+	"👨\u200d👧":               3621,
+	"👨\u200d👧\u200d👦":        3622,
+	"👨\u200d👧\u200d👧":        3623,
+	"👩\u200d👦":               3624,
+	"👩\u200d👦\u200d👦":        3625,
+	"👩\u200d👧":               3626,
+	"👩\u200d👧\u200d👦":        3627,
+	"👩\u200d👧\u200d👧":        3628,
+	"🗣️":                     3629,
+	"🗣":                      3630,
+	"👤":                      3631,
+	"👥":                      3632,
+	"🫂":                      3633,
+	"👪":                      3634,
+	"🧑\u200d🧑\u200d🧒":        3635,
+	"🧑\u200d🧑\u200d🧒\u200d🧒": 3636,
+	"🧑\u200d🧒":               3637,
+	"🧑\u200d🧒\u200d🧒":        3638,
+	"👣":                      3639,
+	"🫆":                      3640,
+	"🦰":                      3641,
+	"🦱":                      3642,
+	"🦳":                      3643,
+	"🦲":                      3644,
+	"🐵":                      3645,
+	"🐒":                      3646,
+	"🦍":                      3647,
+	"🦧":                      3648,
+	"🐶":                      3649,
+	"🐕":                      3650,
+	"🦮":                      3651,
+	"🐕\u200d🦺":               3652,
+	"🐩":                      3653,
+	"🐺":                      3654,
+	"🦊":                      3655,
+	"🦝":                      3656,
+	"🐱":                      3657,
+	"🐈":                      3658,
+	"🐈\u200d⬛":               3659,
+	"🦁":                      3660,
+	"🐯":                      3661,
+	"🐅":                      3662,
+	"🐆":                      3663,
+	"🐴":                      3664,
+	"🫎":                      3665,
+	"🫏":                      3666,
+	"🐎":                      3667,
+	"🦄":                      3668,
+	"🦓":                      3669,
+	"🦌":                      3670,
+	"🦬":                      3671,
+	"🐮":                      3672,
+	"🐂":                      3673,
+	"🐃":                      3674,
+	"🐄":                      3675,
+	"🐷":                      3676,
+	"🐖":                      3677,
+	"🐗":                      3678,
+	"🐽":                      3679,
+	"🐏":                      3680,
+	"🐑":                      3681,
+	"🐐":                      3682,
+	"🐪":                      3683,
+	"🐫":                      3684,
+	"🦙":                      3685,
+	"🦒":                      3686,
+	"🐘":                      3687,
+	"🦣":                      3688,
+	"🦏":                      3689,
+	"🦛":                      3690,
+	"🐭":                      3691,
+	"🐁":                      3692,
+	"🐀":                      3693,
+	"🐹":                      3694,
+	"🐰":                      3695,
+	"🐇":                      3696,
+	"🐿️":                     3697,
+	"🐿":                      3698,
+	"🦫":                      3699,
+	"🦔":                      3700,
+	"🦇":                      3701,
+	"🐻":                      3702,
+	"🐻\u200d❄️":              3703,
+	"🐻\u200d❄":               3704,
+	"🐨":                      3705,
+	"🐼":                      3706,
+	"🦥":                      3707,
+	"🦦":                      3708,
+	"🦨":                      3709,
+	"🦘":                      3710,
+	"🦡":                      3711,
+	"🐾":                      3712,
+	"🦃":                      3713,
+	"🐔":                      3714,
+	"🐓":                      3715,
+	"🐣":                      3716,
+	"🐤":                      3717,
+	"🐥":                      3718,
+	"🐦":                      3719,
+	"🐧":                      3720,
+	"🕊️":                     3721,
+	"🕊":                      3722,
+	"🦅":                      3723,
+	"🦆":                      3724,
+	"🦢":                      3725,
+	"🦉":                      3726,
+	"🦤":                      3727,
+	"🪶":                      3728,
+	"🦩":                      3729,
+	"🦚":                      3730,
+	"🦜":                      3731,
+	"🪽":                      3732,
+	"🐦\u200d⬛":               3733,
+	"🪿":                      3734,
+	"🐦\u200d🔥":               3735,
+	"🐸":                      3736,
+	"🐊":                      3737,
+	"🐢":                      3738,
+	"🦎":                      3739,
+	"🐍":                      3740,
+	"🐲":                      3741,
+	"🐉":                      3742,
+	"🦕":                      3743,
+	"🦖":                      3744,
+	"🐳":                      3745,
+	"🐋":                      3746,
+	"🐬":                      3747,
+	"🦭":                      3748,
+	"🐟":                      3749,
+	"🐠":                      3750,
+	"🐡":                      3751,
+	"🦈":                      3752,
+	"🐙":                      3753,
+	"🐚":                      3754,
+	"🪸":                      3755,
+	"🪼":                      3756,
+	"🫍":                      3757,
+	"🐌":                      3758,
+	"🦋":                      3759,
+	"🐛":                      3760,
+	"🐜":                      3761,
+	"🐝":                      3762,
+	"🪲":                      3763,
+	"🐞":                      3764,
+	"🦗":                      3765,
+	"🪳":                      3766,
+	"🕷️":                     3767,
+	"🕷":                      3768,
+	"🕸️":                     3769,
+	"🕸":                      3770,
+	"🦂":                      3771,
+	"🦟":                      3772,
+	"🪰":                      3773,
+	"🪱":                      3774,
+	"🦠":                      3775,
+	"💐":                      3776,
+	"🌸":                      3777,
+	"💮":                      3778,
+	"🪷":                      3779,
+	"🏵️":                     3780,
+	"🏵":                      3781,
+	"🌹":                      3782,
+	"🥀":                      3783,
+	"🌺":                      3784,
+	"🌻":                      3785,
+	"🌼":                      3786,
+	"🌷":                      3787,
+	"🪻":                      3788,
+	"🌱":                      3789,
+	"🪴":                      3790,
+	"🌲":                      3791,
+	"🌳":                      3792,
+	"🌴":                      3793,
+	"🌵":                      3794,
+	"🌾":                      3795,
+	"🌿":                      3796,
+	"☘️":                     3797,
+	"☘":                      3798,
+	"🍀":                      3799,
+	"🍁":                      3800,
+	"🍂":                      3801,
+	"🍃":                      3802,
+	"🪹":                      3803,
+	"🪺":                      3804,
+	"🍄":                      3805,
+	"🪾":                      3806,
+	"🍇":                      3807,
+	"🍈":                      3808,
+	"🍉":                      3809,
+	"🍊":                      3810,
+	"🍋":                      3811,
+	"🍋\u200d🟩":               3812,
+	"🍌":                      3813,
+	"🍍":                      3814,
+	"🥭":                      3815,
+	"🍎":                      3816,
+	"🍏":                      3817,
+	"🍐":                      3818,
+	"🍑":                      3819,
+	"🍒":                      3820,
+	"🍓":                      3821,
+	"🫐":                      3822,
+	"🥝":                      3823,
+	"🍅":                      3824,
+	"🫒":                      3825,
+	"🥥":                      3826,
+	"🥑":                      3827,
+	"🍆":                      3828,
+	"🥔":                      3829,
+	"🥕":                      3830,
+	"🌽":                      3831,
+	"🌶️":                     3832,
+	"🌶":                      3833,
+	"🫑":                      3834,
+	"🥒":                      3835,
+	"🥬":                      3836,
+	"🥦":                      3837,
+	"🧄":                      3838,
+	"🧅":                      3839,
+	"🥜":                      3840,
+	"🫘":                      3841,
+	"🌰":                      3842,
+	"🫚":                      3843,
+	"🫛":                      3844,
+	"🍄\u200d🟫":               3845,
+	"🫜":                      3846,
+	"🍞":                      3847,
+	"🥐":                      3848,
+	"🥖":                      3849,
+	"🫓":                      3850,
+	"🥨":                      3851,
+	"🥯":                      3852,
+	"🥞":                      3853,
+	"🧇":                      3854,
+	"🧀":                      3855,
+	"🍖":                      3856,
+	"🍗":                      3857,
+	"🥩":                      3858,
+	"🥓":                      3859,
+	"🍔":                      3860,
+	"🍟":                      3861,
+	"🍕":                      3862,
+	"🌭":                      3863,
+	"🥪":                      3864,
+	"🌮":                      3865,
+	"🌯":                      3866,
+	"🫔":                      3867,
+	"🥙":                      3868,
+	"🧆":                      3869,
+	"🥚":                      3870,
+	"🍳":                      3871,
+	"🥘":                      3872,
+	"🍲":                      3873,
+	"🫕":                      3874,
+	"🥣":                      3875,
+	"🥗":                      3876,
+	"🍿":                      3877,
+	"🧈":                      3878,
+	"🧂":                      3879,
+	"🥫":                      3880,
+	"🍱":                      3881,
+	"🍘":                      3882,
+	"🍙":                      3883,
+	"🍚":                      3884,
+	"🍛":                      3885,
+	"🍜":                      3886,
+	"🍝":                      3887,
+	"🍠":                      3888,
+	"🍢":                      3889,
+	"🍣":                      3890,
+	"🍤":                      3891,
+	"🍥":                      3892,
+	"🥮":                      3893,
+	"🍡":                      3894,
+	"🥟":                      3895,
+	"🥠":                      3896,
+	"🥡":                      3897,
+	"🦀":                      3898,
+	"🦞":                      3899,
+	"🦐":                      3900,
+	"🦑":                      3901,
+	"🦪":                      3902,
+	"🍦":                      3903,
+	"🍧":                      3904,
+	"🍨":                      3905,
+	"🍩":                      3906,
+	"🍪":                      3907,
+	"🎂":                      3908,
+	"🍰":                      3909,
+	"🧁":                      3910,
+	"🥧":                      3911,
+	"🍫":                      3912,
+	"🍬":                      3913,
+	"🍭":                      3914,
+	"🍮":                      3915,
+	"🍯":                      3916,
+	"🍼":                      3917,
+	"🥛":                      3918,
+	"☕":                      3919,
+	"🫖":                      3920,
+	"🍵":                      3921,
+	"🍶":                      3922,
+	"🍾":                      3923,
+	"🍷":                      3924,
+	"🍸":                      3925,
+	"🍹":                      3926,
+	"🍺":                      3927,
+	"🍻":                      3928,
+	"🥂":                      3929,
+	"🥃":                      3930,
+	"🫗":                      3931,
+	"🥤":                      3932,
+	"🧋":                      3933,
+	"🧃":                      3934,
+	"🧉":                      3935,
+	"🧊":                      3936,
+	"🥢":                      3937,
+	"🍽️":                     3938,
+	"🍽":                      3939,
+	"🍴":                      3940,
+	"🥄":                      3941,
+	"🔪":                      3942,
+	"🫙":                      3943,
+	"🏺":                      3944,
+	"🌍":                      3945,
+	"🌎":                      3946,
+	"🌏":                      3947,
+	"🌐":                      3948,
+	"🗺️":                     3949,
+	"🗺":                      3950,
+	"🗾":                      3951,
+	"🧭":                      3952,
+	"🏔️":                     3953,
+	"🏔":                      3954,
+	"⛰️":                     3955,
+	"⛰":                      3956,
+	"🌋":                      3957,
+	"🗻":                      3958,
+	"🏕️":                     3959,
+	"🏕":                      3960,
+	"🏖️":                     3961,
+	"🏖":                      3962,
+	"🏜️":                     3963,
+	"🏜":                      3964,
+	"🏝️":                     3965,
+	"🏝":                      3966,
+	"🏞️":                     3967,
+	"🏞":                      3968,
+	"🛘":                      3969,
+	"🏟️":                     3970,
+	"🏟":                      3971,
+	"🏛️":                     3972,
+	"🏛":                      3973,
+	"🏗️":                     3974,
+	"🏗":                      3975,
+	"🧱":                      3976,
+	"🪨":                      3977,
+	"🪵":                      3978,
+	"🛖":                      3979,
+	"🏘️":                     3980,
+	"🏘":                      3981,
+	"🏚️":                     3982,
+	"🏚":                      3983,
+	"🏠":                      3984,
+	"🏡":                      3985,
+	"🏢":                      3986,
+	"🏣":                      3987,
+	"🏤":                      3988,
+	"🏥":                      3989,
+	"🏦":                      3990,
+	"🏨":                      3991,
+	"🏩":                      3992,
+	"🏪":                      3993,
+	"🏫":                      3994,
+	"🏬":                      3995,
+	"🏭":                      3996,
+	"🏯":                      3997,
+	"🏰":                      3998,
+	"💒":                      3999,
+	"🗼":                      4000,
+	"🗽":                      4001,
+	"⛪":                      4002,
+	"🕌":                      4003,
+	"🛕":                      4004,
+	"🕍":                      4005,
+	"⛩️":                     4006,
+	"⛩":                      4007,
+	"🕋":                      4008,
+	"⛲":                      4009,
+	"⛺":                      4010,
+	"🌁":                      4011,
+	"🌃":                      4012,
+	"🏙️":                     4013,
+	"🏙":                      4014,
+	"🌄":                      4015,
+	"🌅":                      4016,
+	"🌆":                      4017,
+	"🌇":                      4018,
+	"🌉":                      4019,
+	"♨️":                     4020,
+	"♨":                      4021,
+	"🎠":                      4022,
+	"🛝":                      4023,
+	"🎡":                      4024,
+	"🎢":                      4025,
+	"💈":                      4026,
+	"🎪":                      4027,
+	"🚂":                      4028,
+	"🚃":                      4029,
+	"🚄":                      4030,
+	"🚅":                      4031,
+	"🚆":                      4032,
+	"🚇":                      4033,
+	"🚈":                      4034,
+	"🚉":                      4035,
+	"🚊":                      4036,
+	"🚝":                      4037,
+	"🚞":                      4038,
+	"🚋":                      4039,
+	"🚌":                      4040,
+	"🚍":                      4041,
+	"🚎":                      4042,
+	"🚐":                      4043,
+	"🚑":                      4044,
+	"🚒":                      4045,
+	"🚓":                      4046,
+	"🚔":                      4047,
+	"🚕":                      4048,
+	"🚖":                      4049,
+	"🚗":                      4050,
+	"🚘":                      4051,
+	"🚙":                      4052,
+	"🛻":                      4053,
+	"🚚":                      4054,
+	"🚛":                      4055,
+	"🚜":                      4056,
+	"🏎️":                     4057,
+	"🏎":                      4058,
+	"🏍️":                     4059,
+	"🏍":                      4060,
+	"🛵":                      4061,
+	"🦽":                      4062,
+	"🦼":                      4063,
+	"🛺":                      4064,
+	"🚲":                      4065,
+	"🛴":                      4066,
+	"🛹":                      4067,
+	"🛼":                      4068,
+	"🚏":                      4069,
+	"🛣️":                     4070,
+	"🛣":                      4071,
+	"🛤️":                     4072,
+	"🛤":                      4073,
+	"🛢️":                     4074,
+	"🛢":                      4075,
+	"⛽":                      4076,
+	"🛞":                      4077,
+	"🚨":                      4078,
+	"🚥":                      4079,
+	"🚦":                      4080,
+	"🛑":                      4081,
+	"🚧":                      4082,
+	"⚓":                      4083,
+	"🛟":                      4084,
+	"⛵":                      4085,
+	"🛶":                      4086,
+	"🚤":                      4087,
+	"🛳️":                     4088,
+	"🛳":                      4089,
+	"⛴️":                     4090,
+	"⛴":                      4091,
+	"🛥️":                     4092,
+	"🛥":                      4093,
+	"🚢":                      4094,
+	"✈️":                     4095,
+	"✈":                      4096,
+	"🛩️":                     4097,
+	"🛩":                      4098,
+	"🛫":                      4099,
+	"🛬":                      4100,
+	"🪂":                      4101,
+	"💺":                      4102,
+	"🚁":                      4103,
+	"🚟":                      4104,
+	"🚠":                      4105,
+	"🚡":                      4106,
+	"🛰️":                     4107,
+	"🛰":                      4108,
+	"🚀":                      4109,
+	"🛸":                      4110,
+	"🛎️":                     4111,
+	"🛎":                      4112,
+	"🧳":                      4113,
+	"⌛":                      4114,
+	"⏳":                      4115,
+	"⌚":                      4116,
+	"⏰":                      4117,
+	"⏱️":                     4118,
+	"⏱":                      4119,
+	"⏲️":                     4120,
+	"⏲":                      4121,
+	"🕰️":                     4122,
+	"🕰":                      4123,
+	"🕛":                      4124,
+	"🕧":                      4125,
+	"🕐":                      4126,
+	"🕜":                      4127,
+	"🕑":                      4128,
+	"🕝":                      4129,
+	"🕒":                      4130,
+	"🕞":                      4131,
+	"🕓":                      4132,
+	"🕟":                      4133,
+	"🕔":                      4134,
+	"🕠":                      4135,
+	"🕕":                      4136,
+	"🕡":                      4137,
+	"🕖":                      4138,
+	"🕢":                      4139,
+	"🕗":                      4140,
+	"🕣":                      4141,
+	"🕘":                      4142,
+	"🕤":                      4143,
+	"🕙":                      4144,
+	"🕥":                      4145,
+	"🕚":                      4146,
+	"🕦":                      4147,
+	"🌑":                      4148,
+	"🌒":                      4149,
+	"🌓":                      4150,
+	"🌔":                      4151,
+	"🌕":                      4152,
+	"🌖":                      4153,
+	"🌗":                      4154,
+	"🌘":                      4155,
+	"🌙":                      4156,
+	"🌚":                      4157,
+	"🌛":                      4158,
+	"🌜":                      4159,
+	"🌡️":                     4160,
+	"🌡":                      4161,
+	"☀️":                     4162,
+	"☀":                      4163,
+	"🌝":                      4164,
+	"🌞":                      4165,
+	"🪐":                      4166,
+	"⭐":                      4167,
+	"🌟":                      4168,
+	"🌠":                      4169,
+	"🌌":                      4170,
+	"☁️":                     4171,
+	"☁":                      4172,
+	"⛅":                      4173,
+	"⛈️":                     4174,
+	"⛈":                      4175,
+	"🌤️":                     4176,
+	"🌤":                      4177,
+	"🌥️":                     4178,
+	"🌥":                      4179,
+	"🌦️":                     4180,
+	"🌦":                      4181,
+	"🌧️":                     4182,
+	"🌧":                      4183,
+	"🌨️":                     4184,
+	"🌨":                      4185,
+	"🌩️":                     4186,
+	"🌩":                      4187,
+	"🌪️":                     4188,
+	"🌪":                      4189,
+	"🌫️":                     4190,
+	"🌫":                      4191,
+	"🌬️":                     4192,
+	"🌬":                      4193,
+	"🌀":                      4194,
+	"🌈":                      4195,
+	"🌂":                      4196,
+	"☂️":                     4197,
+	"☂":                      4198,
+	"☔":                      4199,
+	"⛱️":                     4200,
+	"⛱":                      4201,
+	"⚡":                      4202,
+	"❄️":                     4203,
+	"❄":                      4204,
+	"☃️":                     4205,
+	"☃":                      4206,
+	"⛄":                      4207,
+	"☄️":                     4208,
+	"☄":                      4209,
+	"🔥":                      4210,
+	"💧":                      4211,
+	"🌊":                      4212,
+	"🎃":                      4213,
+	"🎄":                      4214,
+	"🎆":                      4215,
+	"🎇":                      4216,
+	"🧨":                      4217,
+	"✨":                      4218,
+	"🎈":                      4219,
+	"🎉":                      4220,
+	"🎊":                      4221,
+	"🎋":                      4222,
+	"🎍":                      4223,
+	"🎎":                      4224,
+	"🎏":                      4225,
+	"🎐":                      4226,
+	"🎑":                      4227,
+	"🧧":                      4228,
+	"🎀":                      4229,
+	"🎁":                      4230,
+	"🎗️":                     4231,
+	"🎗":                      4232,
+	"🎟️":                     4233,
+	"🎟":                      4234,
+	"🎫":                      4235,
+	"🎖️":                     4236,
+	"🎖":                      4237,
+	"🏆":                      4238,
+	"🏅":                      4239,
+	"🥇":                      4240,
+	"🥈":                      4241,
+	"🥉":                      4242,
+	"⚽":                      4243,
+	"⚾":                      4244,
+	"🥎":                      4245,
+	"🏀":                      4246,
+	"🏐":                      4247,
+	"🏈":                      4248,
+	"🏉":                      4249,
+	"🎾":                      4250,
+	"🥏":                      4251,
+	"🎳":                      4252,
+	"🏏":                      4253,
+	"🏑":                      4254,
+	"🏒":                      4255,
+	"🥍":                      4256,
+	"🏓":                      4257,
+	"🏸":                      4258,
+	"🥊":                      4259,
+	"🥋":                      4260,
+	"🥅":                      4261,
+	"⛳":                      4262,
+	"⛸️":                     4263,
+	"⛸":                      4264,
+	"🎣":                      4265,
+	"🤿":                      4266,
+	"🎽":                      4267,
+	"🎿":                      4268,
+	"🛷":                      4269,
+	"🥌":                      4270,
+	"🎯":                      4271,
+	"🪀":                      4272,
+	"🪁":                      4273,
+	"🔫":                      4274,
+	"🎱":                      4275,
+	"🔮":                      4276,
+	"🪄":                      4277,
+	"🎮":                      4278,
+	"🕹️":                     4279,
+	"🕹":                      4280,
+	"🎰":                      4281,
+	"🎲":                      4282,
+	"🧩":                      4283,
+	"🧸":                      4284,
+	"🪅":                      4285,
+	"🪩":                      4286,
+	"🪆":                      4287,
+	"♠️":                     4288,
+	"♠":                      4289,
+	"♥️":                     4290,
+	"♥":                      4291,
+	"♦️":                     4292,
+	"♦":                      4293,
+	"♣️":                     4294,
+	"♣":                      4295,
+	"♟️":                     4296,
+	"♟":                      4297,
+	"🃏":                      4298,
+	"🀄":                      4299,
+	"🎴":                      4300,
+	"🎭":                      4301,
+	"🖼️":                     4302,
+	"🖼":                      4303,
+	"🎨":                      4304,
+	"🧵":                      4305,
+	"🪡":                      4306,
+	"🧶":                      4307,
+	"🪢":                      4308,
+	"👓":                      4309,
+	"🕶️":                     4310,
+	"🕶":                      4311,
+	"🥽":                      4312,
+	"🥼":                      4313,
+	"🦺":                      4314,
+	"👔":                      4315,
+	"👕":                      4316,
+	"👖":                      4317,
+	"🧣":                      4318,
+	"🧤":                      4319,
+	"🧥":                      4320,
+	"🧦":                      4321,
+	"👗":                      4322,
+	"👘":                      4323,
+	"🥻":                      4324,
+	"🩱":                      4325,
+	"🩲":                      4326,
+	"🩳":                      4327,
+	"👙":                      4328,
+	"👚":                      4329,
+	"🪭":                      4330,
+	"👛":                      4331,
+	"👜":                      4332,
+	"👝":                      4333,
+	"🛍️":                     4334,
+	"🛍":                      4335,
+	"🎒":                      4336,
+	"🩴":                      4337,
+	"👞":                      4338,
+	"👟":                      4339,
+	"🥾":                      4340,
+	"🥿":                      4341,
+	"👠":                      4342,
+	"👡":                      4343,
+	"🩰":                      4344,
+	"👢":                      4345,
+	"🪮":                      4346,
+	"👑":                      4347,
+	"👒":                      4348,
+	"🎩":                      4349,
+	"🎓":                      4350,
+	"🧢":                      4351,
+	"🪖":                      4352,
+	"⛑️":                     4353,
+	"⛑":                      4354,
+	"📿":                      4355,
+	"💄":                      4356,
+	"💍":                      4357,
+	"💎":                      4358,
+	"🔇":                      4359,
+	"🔈":                      4360,
+	"🔉":                      4361,
+	"🔊":                      4362,
+	"📢":                      4363,
+	"📣":                      4364,
+	"📯":                      4365,
+	"🔔":                      4366,
+	"🔕":                      4367,
+	"🎼":                      4368,
+	"🎵":                      4369,
+	"🎶":                      4370,
+	"🎙️":                     4371,
+	"🎙":                      4372,
+	"🎚️":                     4373,
+	"🎚":                      4374,
+	"🎛️":                     4375,
+	"🎛":                      4376,
+	"🎤":                      4377,
+	"🎧":                      4378,
+	"📻":                      4379,
+	"🎷":                      4380,
+	"🪗":                      4381,
+	"🎸":                      4382,
+	"🎹":                      4383,
+	"🎺":                      4384,
+	"🎻":                      4385,
+	"🪕":                      4386,
+	"🥁":                      4387,
+	"🪘":                      4388,
+	"🪇":                      4389,
+	"🪈":                      4390,
+	"🪉":                      4391,
+	"🪊":                      4392,
+	"📱":                      4393,
+	"📲":                      4394,
+	"☎️":                     4395,
+	"☎":                      4396,
+	"📞":                      4397,
+	"📟":                      4398,
+	"📠":                      4399,
+	"🔋":                      4400,
+	"🪫":                      4401,
+	"🔌":                      4402,
+	"💻":                      4403,
+	"🖥️":                     4404,
+	"🖥":                      4405,
+	"🖨️":                     4406,
+	"🖨":                      4407,
+	"⌨️":                     4408,
+	"⌨":                      4409,
+	"🖱️":                     4410,
+	"🖱":                      4411,
+	"🖲️":                     4412,
+	"🖲":                      4413,
+	"💽":                      4414,
+	"💾":                      4415,
+	"💿":                      4416,
+	"📀":                      4417,
+	"🧮":                      4418,
+	"🎥":                      4419,
+	"🎞️":                     4420,
+	"🎞":                      4421,
+	"📽️":                     4422,
+	"📽":                      4423,
+	"🎬":                      4424,
+	"📺":                      4425,
+	"📷":                      4426,
+	"📸":                      4427,
+	"📹":                      4428,
+	"📼":                      4429,
+	"🔍":                      4430,
+	"🔎":                      4431,
+	"🕯️":                     4432,
+	"🕯":                      4433,
+	"💡":                      4434,
+	"🔦":                      4435,
+	"🏮":                      4436,
+	"🪔":                      4437,
+	"📔":                      4438,
+	"📕":                      4439,
+	"📖":                      4440,
+	"📗":                      4441,
+	"📘":                      4442,
+	"📙":                      4443,
+	"📚":                      4444,
+	"📓":                      4445,
+	"📒":                      4446,
+	"📃":                      4447,
+	"📜":                      4448,
+	"📄":                      4449,
+	"📰":                      4450,
+	"🗞️":                     4451,
+	"🗞":                      4452,
+	"📑":                      4453,
+	"🔖":                      4454,
+	"🏷️":                     4455,
+	"🏷":                      4456,
+	"💰":                      4457,
+	"🪙":                      4458,
+	"💴":                      4459,
+	"💵":                      4460,
+	"💶":                      4461,
+	"💷":                      4462,
+	"💸":                      4463,
+	"💳":                      4464,
+	"🧾":                      4465,
+	"💹":                      4466,
+	"🪎":                      4467,
+	"✉️":                     4468,
+	"✉":                      4469,
+	"📧":                      4470,
+	"📨":                      4471,
+	"📩":                      4472,
+	"📤":                      4473,
+	"📥":                      4474,
+	"📦":                      4475,
+	"📫":                      4476,
+	"📪":                      4477,
+	"📬":                      4478,
+	"📭":                      4479,
+	"📮":                      4480,
+	"🗳️":                     4481,
+	"🗳":                      4482,
+	"✏️":                     4483,
+	"✏":                      4484,
+	"✒️":                     4485,
+	"✒":                      4486,
+	"🖋️":                     4487,
+	"🖋":                      4488,
+	"🖊️":                     4489,
+	"🖊":                      4490,
+	"🖌️":                     4491,
+	"🖌":                      4492,
+	"🖍️":                     4493,
+	"🖍":                      4494,
+	"📝":                      4495,
+	"💼":                      4496,
+	"📁":                      4497,
+	"📂":                      4498,
+	"🗂️":                     4499,
+	"🗂":                      4500,
+	"📅":                      4501,
+	"📆":                      4502,
+	"🗒️":                     4503,
+	"🗒":                      4504,
+	"🗓️":                     4505,
+	"🗓":                      4506,
+	"📇":                      4507,
+	"📈":                      4508,
+	"📉":                      4509,
+	"📊":                      4510,
+	"📋":                      4511,
+	"📌":                      4512,
+	"📍":                      4513,
+	"📎":                      4514,
+	"🖇️":                     4515,
+	"🖇":                      4516,
+	"📏":                      4517,
+	"📐":                      4518,
+	"✂️":                     4519,
+	"✂":                      4520,
+	"🗃️":                     4521,
+	"🗃":                      4522,
+	"🗄️":                     4523,
+	"🗄":                      4524,
+	"🗑️":                     4525,
+	"🗑":                      4526,
+	"🔒":                      4527,
+	"🔓":                      4528,
+	"🔏":                      4529,
+	"🔐":                      4530,
+	"🔑":                      4531,
+	"🗝️":                     4532,
+	"🗝":                      4533,
+	"🔨":                      4534,
+	"🪓":                      4535,
+	"⛏️":                     4536,
+	"⛏":                      4537,
+	"⚒️":                     4538,
+	"⚒":                      4539,
+	"🛠️":                     4540,
+	"🛠":                      4541,
+	"🗡️":                     4542,
+	"🗡":                      4543,
+	"⚔️":                     4544,
+	"⚔":                      4545,
+	"💣":                      4546,
+	"🪃":                      4547,
+	"🏹":                      4548,
+	"🛡️":                     4549,
+	"🛡":                      4550,
+	"🪚":                      4551,
+	"🔧":                      4552,
+	"🪛":                      4553,
+	"🔩":                      4554,
+	"⚙️":                     4555,
+	"⚙":                      4556,
+	"🗜️":                     4557,
+	"🗜":                      4558,
+	"⚖️":                     4559,
+	"⚖":                      4560,
+	"🦯":                      4561,
+	"🔗":                      4562,
+	"⛓️\u200d💥":              4563,
+	"⛓\u200d💥":               4564,
+	"⛓️":                     4565,
+	"⛓":                      4566,
+	"🪝":                      4567,
+	"🧰":                      4568,
+	"🧲":                      4569,
+	"🪜":                      4570,
+	"🪏":                      4571,
+	"⚗️":                     4572,
+	"⚗":                      4573,
+	"🧪":                      4574,
+	"🧫":                      4575,
+	"🧬":                      4576,
+	"🔬":                      4577,
+	"🔭":                      4578,
+	"📡":                      4579,
+	"💉":                      4580,
+	"🩸":                      4581,
+	"💊":                      4582,
+	"🩹":                      4583,
+	"🩼":                      4584,
+	"🩺":                      4585,
+	"🩻":                      4586,
+	"🚪":                      4587,
+	"🛗":                      4588,
+	"🪞":                      4589,
+	"🪟":                      4590,
+	"🛏️":                     4591,
+	"🛏":                      4592,
+	"🛋️":                     4593,
+	"🛋":                      4594,
+	"🪑":                      4595,
+	"🚽":                      4596,
+	"🪠":                      4597,
+	"🚿":                      4598,
+	"🛁":                      4599,
+	"🪤":                      4600,
+	"🪒":                      4601,
+	"🧴":                      4602,
+	"🧷":                      4603,
+	"🧹":                      4604,
+	"🧺":                      4605,
+	"🧻":                      4606,
+	"🪣":                      4607,
+	"🧼":                      4608,
+	"🫧":                      4609,
+	"🪥":                      4610,
+	"🧽":                      4611,
+	"🧯":                      4612,
+	"🛒":                      4613,
+	"🚬":                      4614,
+	"⚰️":                     4615,
+	"⚰":                      4616,
+	"🪦":                      4617,
+	"⚱️":                     4618,
+	"⚱":                      4619,
+	"🧿":                      4620,
+	"🪬":                      4621,
+	"🗿":                      4622,
+	"🪧":                      4623,
+	"🪪":                      4624,
+	"🏧":                      4625,
+	"🚮":                      4626,
+	"🚰":                      4627,
+	"♿":                      4628,
+	"🚹":                      4629,
+	"🚺":                      4630,
+	"🚻":                      4631,
+	"🚼":                      4632,
+	"🚾":                      4633,
+	"🛂":                      4634,
+	"🛃":                      4635,
+	"🛄":                      4636,
+	"🛅":                      4637,
+	"⚠️":                     4638,
+	"⚠":                      4639,
+	"🚸":                      4640,
+	"⛔":                      4641,
+	"🚫":                      4642,
+	"🚳":                      4643,
+	"🚭":                      4644,
+	"🚯":                      4645,
+	"🚱":                      4646,
+	"🚷":                      4647,
+	"📵":                      4648,
+	"🔞":                      4649,
+	"☢️":                     4650,
+	"☢":                      4651,
+	"☣️":                     4652,
+	"☣":                      4653,
+	"⬆️":                     4654,
+	"⬆":                      4655,
+	"↗️":                     4656,
+	"↗":                      4657,
+	"➡️":                     4658,
+	"➡":                      4659,
+	"↘️":                     4660,
+	"↘":                      4661,
+	"⬇️":                     4662,
+	"⬇":                      4663,
+	"↙️":                     4664,
+	"↙":                      4665,
+	"⬅️":                     4666,
+	"⬅":                      4667,
+	"↖️":                     4668,
+	"↖":                      4669,
+	"↕️":                     4670,
+	"↕":                      4671,
+	"↔️":                     4672,
+	"↔":                      4673,
+	"↩️":                     4674,
+	"↩":                      4675,
+	"↪️":                     4676,
+	"↪":                      4677,
+	"⤴️":                     4678,
+	"⤴":                      4679,
+	"⤵️":                     4680,
+	"⤵":                      4681,
+	"🔃":                      4682,
+	"🔄":                      4683,
+	"🔙":                      4684,
+	"🔚":                      4685,
+	"🔛":                      4686,
+	"🔜":                      4687,
+	"🔝":                      4688,
+	"🛐":                      4689,
+	"⚛️":                     4690,
+	"⚛":                      4691,
+	"🕉️":                     4692,
+	"🕉":                      4693,
+	"✡️":                     4694,
+	"✡":                      4695,
+	"☸️":                     4696,
+	"☸":                      4697,
+	"☯️":                     4698,
+	"☯":                      4699,
+	"✝️":                     4700,
+	"✝":                      4701,
+	"☦️":                     4702,
+	"☦":                      4703,
+	"☪️":                     4704,
+	"☪":                      4705,
+	"☮️":                     4706,
+	"☮":                      4707,
+	"🕎":                      4708,
+	"🔯":                      4709,
+	"🪯":                      4710,
+	"♈":                      4711,
+	"♉":                      4712,
+	"♊":                      4713,
+	"♋":                      4714,
+	"♌":                      4715,
+	"♍":                      4716,
+	"♎":                      4717,
+	"♏":                      4718,
+	"♐":                      4719,
+	"♑":                      4720,
+	"♒":                      4721,
+	"♓":                      4722,
+	"⛎":                      4723,
+	"🔀":                      4724,
+	"🔁":                      4725,
+	"🔂":                      4726,
+	"▶️":                     4727,
+	"▶":                      4728,
+	"⏩":                      4729,
+	"⏭️":                     4730,
+	"⏭":                      4731,
+	"⏯️":                     4732,
+	"⏯":                      4733,
+	"◀️":                     4734,
+	"◀":                      4735,
+	"⏪":                      4736,
+	"⏮️":                     4737,
+	"⏮":                      4738,
+	"🔼":                      4739,
+	"⏫":                      4740,
+	"🔽":                      4741,
+	"⏬":                      4742,
+	"⏸️":                     4743,
+	"⏸":                      4744,
+	"⏹️":                     4745,
+	"⏹":                      4746,
+	"⏺️":                     4747,
+	"⏺":                      4748,
+	"⏏️":                     4749,
+	"⏏":                      4750,
+	"🎦":                      4751,
+	"🔅":                      4752,
+	"🔆":                      4753,
+	"📶":                      4754,
+	"🛜":                      4755,
+	"📳":                      4756,
+	"📴":                      4757,
+	"♀️":                     4758,
+	"♀":                      4759,
+	"♂️":                     4760,
+	"♂":                      4761,
+	"⚧️":                     4762,
+	"⚧":                      4763,
+	"✖️":                     4764,
+	"✖":                      4765,
+	"➕":                      4766,
+	"➖":                      4767,
+	"➗":                      4768,
+	"🟰":                      4769,
+	"♾️":                     4770,
+	"♾":                      4771,
+	"‼️":                     4772,
+	"‼":                      4773,
+	"⁉️":                     4774,
+	"⁉":                      4775,
+	"❓":                      4776,
+	"❔":                      4777,
+	"❕":                      4778,
+	"❗":                      4779,
+	"〰️":                     4780,
+	"〰":                      4781,
+	"💱":                      4782,
+	"💲":                      4783,
+	"⚕️":                     4784,
+	"⚕":                      4785,
+	"♻️":                     4786,
+	"♻":                      4787,
+	"⚜️":                     4788,
+	"⚜":                      4789,
+	"🔱":                      4790,
+	"📛":                      4791,
+	"🔰":                      4792,
+	"⭕":                      4793,
+	"✅":                      4794,
+	"☑️":                     4795,
+	"☑":                      4796,
+	"✔️":                     4797,
+	"✔":                      4798,
+	"❌":                      4799,
+	"❎":                      4800,
+	"➰":                      4801,
+	"➿":                      4802,
+	"〽️":                     4803,
+	"〽":                      4804,
+	"✳️":                     4805,
+	"✳":                      4806,
+	"✴️":                     4807,
+	"✴":                      4808,
+	"❇️":                     4809,
+	"❇":                      4810,
+	"©️":                     4811,
+	"©":                      4812,
+	"®️":                     4813,
+	"®":                      4814,
+	"™️":                     4815,
+	"™":                      4816,
+	"🫟":                      4817,
+	"#️⃣":                    4818,
+	"#⃣":                     4819,
+	"*️⃣":                    4820,
+	"*⃣":                     4821,
+	"0️⃣":                    4822,
+	"0⃣":                     4823,
+	"1️⃣":                    4824,
+	"1⃣":                     4825,
+	"2️⃣":                    4826,
+	"2⃣":                     4827,
+	"3️⃣":                    4828,
+	"3⃣":                     4829,
+	"4️⃣":                    4830,
+	"4⃣":                     4831,
+	"5️⃣":                    4832,
+	"5⃣":                     4833,
+	"6️⃣":                    4834,
+	"6⃣":                     4835,
+	"7️⃣":                    4836,
+	"7⃣":                     4837,
+	"8️⃣":                    4838,
+	"8⃣":                     4839,
+	"9️⃣":                    4840,
+	"9⃣":                     4841,
+	"🔟":                      4842,
+	"🔠":                      4843,
+	"🔡":                      4844,
+	"🔢":                      4845,
+	"🔣":                      4846,
+	"🔤":                      4847,
+	"🅰️":                     4848,
+	"🅰":                      4849,
+	"🆎":                      4850,
+	"🅱️":                     4851,
+	"🅱":                      4852,
+	"🆑":                      4853,
+	"🆒":                      4854,
+	"🆓":                      4855,
+	"ℹ️":                     4856,
+	"ℹ":                      4857,
+	"🆔":                      4858,
+	"Ⓜ️":                     4859,
+	"Ⓜ":                      4860,
+	"🆕":                      4861,
+	"🆖":                      4862,
+	"🅾️":                     4863,
+	"🅾":                      4864,
+	"🆗":                      4865,
+	"🅿️":                     4866,
+	"🅿":                      4867,
+	"🆘":                      4868,
+	"🆙":                      4869,
+	"🆚":                      4870,
+	"🈁":                      4871,
+	"🈂️":                     4872,
+	"🈂":                      4873,
+	"🈷️":                     4874,
+	"🈷":                      4875,
+	"🈶":                      4876,
+	"🈯":                      4877,
+	"🉐":                      4878,
+	"🈹":                      4879,
+	"🈚":                      4880,
+	"🈲":                      4881,
+	"🉑":                      4882,
+	"🈸":                      4883,
+	"🈴":                      4884,
+	"🈳":                      4885,
+	"㊗️":                     4886,
+	"㊗":                      4887,
+	"㊙️":                     4888,
+	"㊙":                      4889,
+	"🈺":                      4890,
+	"🈵":                      4891,
+	"🔴":                      4892,
+	"🟠":                      4893,
+	"🟡":                      4894,
+	"🟢":                      4895,
+	"🔵":                      4896,
+	"🟣":                      4897,
+	"🟤":                      4898,
+	"⚫":                      4899,
+	"⚪":                      4900,
+	"🟥":                      4901,
+	"🟧":                      4902,
+	"🟨":                      4903,
+	"🟩":                      4904,
+	"🟦":                      4905,
+	"🟪":                      4906,
+	"🟫":                      4907,
+	"⬛":                      4908,
+	"⬜":                      4909,
+	"◼️":                     4910,
+	"◼":                      4911,
+	"◻️":                     4912,
+	"◻":                      4913,
+	"◾":                      4914,
+	"◽":                      4915,
+	"▪️":                     4916,
+	"▪":                      4917,
+	"▫️":                     4918,
+	"▫":                      4919,
+	"🔶":                      4920,
+	"🔷":                      4921,
+	"🔸":                      4922,
+	"🔹":                      4923,
+	"🔺":                      4924,
+	"🔻":                      4925,
+	"💠":                      4926,
+	"🔘":                      4927,
+	"🔳":                      4928,
+	"🔲":                      4929,
+	"🏁":                      4930,
+	"🚩":                      4931,
+	"🎌":                      4932,
+	"🏴":                      4933,
+	"🏳️":                     4934,
+	"🏳":                      4935,
+	"🏳️\u200d🌈":              4936,
+	"🏳\u200d🌈":               4937,
+	"🏳️\u200d⚧️":             4938,
+	"🏳\u200d⚧️":              4939,
+	"🏳️\u200d⚧":              4940,
+	"🏳\u200d⚧":               4941,
+	"🏴\u200d☠️":              4942,
+	"🏴\u200d☠":               4943,
+	"🇦🇨":                     4944,
+	"🇦🇩":                     4945,
+	"🇦🇪":                     4946,
+	"🇦🇫":                     4947,
+	"🇦🇬":                     4948,
+	"🇦🇮":                     4949,
+	"🇦🇱":                     4950,
+	"🇦🇲":                     4951,
+	"🇦🇴":                     4952,
+	"🇦🇶":                     4953,
+	"🇦🇷":                     4954,
+	"🇦🇸":                     4955,
+	"🇦🇹":                     4956,
+	"🇦🇺":                     4957,
+	"🇦🇼":                     4958,
+	"🇦🇽":                     4959,
+	"🇦🇿":                     4960,
+	"🇧🇦":                     4961,
+	"🇧🇧":                     4962,
+	"🇧🇩":                     4963,
+	"🇧🇪":                     4964,
+	"🇧🇫":                     4965,
+	"🇧🇬":                     4966,
+	"🇧🇭":                     4967,
+	"🇧🇮":                     4968,
+	"🇧🇯":                     4969,
+	"🇧🇱":                     4970,
+	"🇧🇲":                     4971,
+	"🇧🇳":                     4972,
+	"🇧🇴":                     4973,
+	"🇧🇶":                     4974,
+	"🇧🇷":                     4975,
+	"🇧🇸":                     4976,
+	"🇧🇹":                     4977,
+	"🇧🇻":                     4978,
+	"🇧🇼":                     4979,
+	"🇧🇾":                     4980,
+	"🇧🇿":                     4981,
+	"🇨🇦":                     4982,
+	"🇨🇨":                     4983,
+	"🇨🇩":                     4984,
+	"🇨🇫":                     4985,
+	"🇨🇬":                     4986,
+	"🇨🇭":                     4987,
+	"🇨🇮":                     4988,
+	"🇨🇰":                     4989,
+	"🇨🇱":                     4990,
+	"🇨🇲":                     4991,
+	"🇨🇳":                     4992,
+	"🇨🇴":                     4993,
+	"🇨🇵":                     4994,
+	"🇨🇶":                     4995,
+	"🇨🇷":                     4996,
+	"🇨🇺":                     4997,
+	"🇨🇻":                     4998,
+	"🇨🇼":                     4999,
+	"🇨🇽":                     5000,
+	"🇨🇾":                     5001,
+	"🇨🇿":                     5002,
+	"🇩🇪":                     5003,
+	"🇩🇬":                     5004,
+	"🇩🇯":                     5005,
+	"🇩🇰":                     5006,
+	"🇩🇲":                     5007,
+	"🇩🇴":                     5008,
+	"🇩🇿":                     5009,
+	"🇪🇦":                     5010,
+	"🇪🇨":                     5011,
+	"🇪🇪":                     5012,
+	"🇪🇬":                     5013,
+	"🇪🇭":                     5014,
+	"🇪🇷":                     5015,
+	"🇪🇸":                     5016,
+	"🇪🇹":                     5017,
+	"🇪🇺":                     5018,
+	"🇫🇮":                     5019,
+	"🇫🇯":                     5020,
+	"🇫🇰":                     5021,
+	"🇫🇲":                     5022,
+	"🇫🇴":                     5023,
+	"🇫🇷":                     5024,
+	"🇬🇦":                     5025,
+	"🇬🇧":                     5026,
+	"🇬🇩":                     5027,
+	"🇬🇪":                     5028,
+	"🇬🇫":                     5029,
+	"🇬🇬":                     5030,
+	"🇬🇭":                     5031,
+	"🇬🇮":                     5032,
+	"🇬🇱":                     5033,
+	"🇬🇲":                     5034,
+	"🇬🇳":                     5035,
+	"🇬🇵":                     5036,
+	"🇬🇶":                     5037,
+	"🇬🇷":                     5038,
+	"🇬🇸":                     5039,
+	"🇬🇹":                     5040,
+	"🇬🇺":                     5041,
+	"🇬🇼":                     5042,
+	"🇬🇾":                     5043,
+	"🇭🇰":                     5044,
+	"🇭🇲":                     5045,
+	"🇭🇳":                     5046,
+	"🇭🇷":                     5047,
+	"🇭🇹":                     5048,
+	"🇭🇺":                     5049,
+	"🇮🇨":                     5050,
+	"🇮🇩":                     5051,
+	"🇮🇪":                     5052,
+	"🇮🇱":                     5053,
+	"🇮🇲":                     5054,
+	"🇮🇳":                     5055,
+	"🇮🇴":                     5056,
+	"🇮🇶":                     5057,
+	"🇮🇷":                     5058,
+	"🇮🇸":                     5059,
+	"🇮🇹":                     5060,
+	"🇯🇪":                     5061,
+	"🇯🇲":                     5062,
+	"🇯🇴":                     5063,
+	"🇯🇵":                     5064,
+	"🇰🇪":                     5065,
+	"🇰🇬":                     5066,
+	"🇰🇭":                     5067,
+	"🇰🇮":                     5068,
+	"🇰🇲":                     5069,
+	"🇰🇳":                     5070,
+	"🇰🇵":                     5071,
+	"🇰🇷":                     5072,
+	"🇰🇼":                     5073,
+	"🇰🇾":                     5074,
+	"🇰🇿":                     5075,
+	"🇱🇦":                     5076,
+	"🇱🇧":                     5077,
+	"🇱🇨":                     5078,
+	"🇱🇮":                     5079,
+	"🇱🇰":                     5080,
+	"🇱🇷":                     5081,
+	"🇱🇸":                     5082,
+	"🇱🇹":                     5083,
+	"🇱🇺":                     5084,
+	"🇱🇻":                     5085,
+	"🇱🇾":                     5086,
+	"🇲🇦":                     5087,
+	"🇲🇨":                     5088,
+	"🇲🇩":                     5089,
+	"🇲🇪":                     5090,
+	"🇲🇫":                     5091,
+	"🇲🇬":                     5092,
+	"🇲🇭":                     5093,
+	"🇲🇰":                     5094,
+	"🇲🇱":                     5095,
+	"🇲🇲":                     5096,
+	"🇲🇳":                     5097,
+	"🇲🇴":                     5098,
+	"🇲🇵":                     5099,
+	"🇲🇶":                     5100,
+	"🇲🇷":                     5101,
+	"🇲🇸":                     5102,
+	"🇲🇹":                     5103,
+	"🇲🇺":                     5104,
+	"🇲🇻":                     5105,
+	"🇲🇼":                     5106,
+	"🇲🇽":                     5107,
+	"🇲🇾":                     5108,
+	"🇲🇿":                     5109,
+	"🇳🇦":                     5110,
+	"🇳🇨":                     5111,
+	"🇳🇪":                     5112,
+	"🇳🇫":                     5113,
+	"🇳🇬":                     5114,
+	"🇳🇮":                     5115,
+	"🇳🇱":                     5116,
+	"🇳🇴":                     5117,
+	"🇳🇵":                     5118,
+	"🇳🇷":                     5119,
+	"🇳🇺":                     5120,
+	"🇳🇿":                     5121,
+	"🇴🇲":                     5122,
+	"🇵🇦":                     5123,
+	"🇵🇪":                     5124,
+	"🇵🇫":                     5125,
+	"🇵🇬":                     5126,
+	"🇵🇭":                     5127,
+	"🇵🇰":                     5128,
+	"🇵🇱":                     5129,
+	"🇵🇲":                     5130,
+	"🇵🇳":                     5131,
+	"🇵🇷":                     5132,
+	"🇵🇸":                     5133,
+	"🇵🇹":                     5134,
+	"🇵🇼":                     5135,
+	"🇵🇾":                     5136,
+	"🇶🇦":                     5137,
+	"🇷🇪":                     5138,
+	"🇷🇴":                     5139,
+	"🇷🇸":                     5140,
+	"🇷🇺":                     5141,
+	"🇷🇼":                     5142,
+	"🇸🇦":                     5143,
+	"🇸🇧":                     5144,
+	"🇸🇨":                     5145,
+	"🇸🇩":                     5146,
+	"🇸🇪":                     5147,
+	"🇸🇬":                     5148,
+	"🇸🇭":                     5149,
+	"🇸🇮":                     5150,
+	"🇸🇯":                     5151,
+	"🇸🇰":                     5152,
+	"🇸🇱":                     5153,
+	"🇸🇲":                     5154,
+	"🇸🇳":                     5155,
+	"🇸🇴":                     5156,
+	"🇸🇷":                     5157,
+	"🇸🇸":                     5158,
+	"🇸🇹":                     5159,
+	"🇸🇻":                     5160,
+	"🇸🇽":                     5161,
+	"🇸🇾":                     5162,
+	"🇸🇿":                     5163,
+	"🇹🇦":                     5164,
+	"🇹🇨":                     5165,
+	"🇹🇩":                     5166,
+	"🇹🇫":                     5167,
+	"🇹🇬":                     5168,
+	"🇹🇭":                     5169,
+	"🇹🇯":                     5170,
+	"🇹🇰":                     5171,
+	"🇹🇱":                     5172,
+	"🇹🇲":                     5173,
+	"🇹🇳":                     5174,
+	"🇹🇴":                     5175,
+	"🇹🇷":                     5176,
+	"🇹🇹":                     5177,
+	"🇹🇻":                     5178,
+	"🇹🇼":                     5179,
+	"🇹🇿":                     5180,
+	"🇺🇦":                     5181,
+	"🇺🇬":                     5182,
+	"🇺🇲":                     5183,
+	"🇺🇳":                     5184,
+	"🇺🇸":                     5185,
+	"🇺🇾":                     5186,
+	"🇺🇿":                     5187,
+	"🇻🇦":                     5188,
+	"🇻🇨":                     5189,
+	"🇻🇪":                     5190,
+	"🇻🇬":                     5191,
+	"🇻🇮":                     5192,
+	"🇻🇳":                     5193,
+	"🇻🇺":                     5194,
+	"🇼🇫":                     5195,
+	"🇼🇸":                     5196,
+	"🇽🇰":                     5197,
+	"🇾🇪":                     5198,
+	"🇾🇹":                     5199,
+	"🇿🇦":                     5200,
+	"🇿🇲":                     5201,
+	"🇿🇼":                     5202,
+	"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f": 5203,
+	"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f": 5204,
+	"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f": 5205,
+}
@@ -0,0 +1,17 @@
+package gomoji
+
+import "strings"
+
+// RegionalIndicatorsToASCII replaces every regional indicator in the s string, including the ones forming flags,
+// with its ASCII capital letter and returns a new string, e.g. "🇭🇮 🇺🇸" becomes "HI US". It reveals words
+// spelled with regional indicators, which is useful for moderation. Regional indicators U+1F1E6..U+1F1FF
+// stand for the letters A to Z; unlike matching (see WithoutRegionalIndicators), the replacement does not
+// depend on how a run of them is paired.
+func RegionalIndicatorsToASCII(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isRegionalIndicator(r) {
+			return r - firstRegionalIndicator + 'A'
+		}
+		return r
+	}, s)
+}
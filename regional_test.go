@@ -0,0 +1,100 @@
+package gomoji_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestRegionalIndicatorPairing(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     []string
+		wantFlag []string
+	}{
+		{
+			name:     "flag",
+			inputStr: "🇺🇸",
+			want:     []string{"🇺🇸"},
+			wantFlag: []string{"🇺🇸"},
+		},
+		{
+			name:     "two flags",
+			inputStr: "🇺🇸🇩🇪",
+			want:     []string{"🇺🇸", "🇩🇪"},
+			wantFlag: []string{"🇺🇸", "🇩🇪"},
+		},
+		{
+			name:     "odd run is paired from its start",
+			inputStr: "🇦🇺🇸",
+			want:     []string{"🇦🇺", "🇸"},
+			wantFlag: []string{"🇦🇺"},
+		},
+		{
+			name:     "pair that is not a flag",
+			inputStr: "🇦🇦",
+			want:     []string{"🇦", "🇦"},
+			wantFlag: nil,
+		},
+		{
+			name:     "lone letters separated by spaces",
+			inputStr: "🇦 🇧 🇨",
+			want:     []string{"🇦", "🇧", "🇨"},
+			wantFlag: nil,
+		},
+	}
+
+	flagsOnly := gomoji.New(gomoji.WithoutRegionalIndicators())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchedStrings(gomoji.Matches(tt.inputStr)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Matches() = %q, want %q", got, tt.want)
+			}
+			if got := matchedStrings(flagsOnly.Matches(tt.inputStr)); !reflect.DeepEqual(got, tt.wantFlag) {
+				t.Errorf("Matches() without regional indicators = %q, want %q", got, tt.wantFlag)
+			}
+		})
+	}
+}
+
+func TestRegionalIndicatorsToASCII(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "flags and lone letters",
+			inputStr: "🇭🇮 🇺🇸 🇦",
+			want:     "HI US A",
+		},
+		{
+			name:     "other emojis are kept",
+			inputStr: "🦋 🇩🇪!",
+			want:     "🦋 DE!",
+		},
+		{
+			name:     "no regional indicators",
+			inputStr: "hello",
+			want:     "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.RegionalIndicatorsToASCII(tt.inputStr); got != tt.want {
+				t.Errorf("RegionalIndicatorsToASCII() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func matchedStrings(matches []gomoji.Match) []string {
+	var strs []string
+	for _, m := range matches {
+		strs = append(strs, m.Str)
+	}
+
+	return strs
+}
@@ -0,0 +1,168 @@
+package gomoji
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Scanner reads emojis from an io.Reader one by one without loading the whole input into memory.
+// Grapheme clusters that straddle read boundaries are reassembled before lookup, so a Scanner
+// yields exactly the same matches as Matches would for the whole input.
+type Scanner struct {
+	m  *Matcher
+	sc *bufio.Scanner
+
+	pending []Match
+	match   Match
+
+	byteOffset int
+	runeOffset int
+	grapheme   int
+}
+
+// NewScanner returns a new Scanner to read emojis from r.
+func NewScanner(r io.Reader) *Scanner {
+	return defaultMatcher.NewScanner(r)
+}
+
+// NewScanner returns a new Scanner to read the emojis of the Matcher from r.
+func (m *Matcher) NewScanner(r io.Reader) *Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Split(scanGraphemes)
+
+	return &Scanner{m: m, sc: sc}
+}
+
+// Buffer sets the initial buffer and the maximum buffer size of the underlying bufio.Scanner.
+// The maximum size limits the length of a single grapheme cluster. It must be called before the first Scan.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.sc.Buffer(buf, max)
+}
+
+// Scan advances the Scanner to the next emoji, which will then be available through the Match method.
+// It returns false when the scan stops, either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	for len(s.pending) == 0 {
+		if !s.sc.Scan() {
+			return false
+		}
+
+		cluster := s.sc.Text()
+		s.pending = s.m.appendClusterMatches(s.pending[:0], cluster, s.byteOffset, s.runeOffset, s.grapheme)
+
+		s.byteOffset += len(cluster)
+		s.runeOffset += utf8.RuneCountInString(cluster)
+		s.grapheme++
+	}
+
+	s.match = s.pending[0]
+	s.pending = s.pending[1:]
+
+	return true
+}
+
+// Match returns the most recent emoji found by a call to Scan.
+// Its offsets are relative to the beginning of the input.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.sc.Err()
+}
+
+// ScanEmojis returns a split function for a bufio.Scanner that returns each emoji as a separate token,
+// and every run of text between emojis as another token. Variation selectors following an emoji belong to
+// the emoji token, and the emoji tokens are exactly the matches of Matches. A grapheme cluster that is not
+// an emoji as a whole is split only once, so the split function keeps state and must not be shared between
+// scanners. A run of text longer than 4 KiB is returned in several tokens at grapheme cluster boundaries, so
+// inputs without emojis do not need to fit into the buffer of the bufio.Scanner.
+// Use GetInfo or Matches to tell emoji tokens from text tokens.
+func ScanEmojis() bufio.SplitFunc {
+	return defaultMatcher.ScanEmojis()
+}
+
+// ScanEmojis returns a split function for a bufio.Scanner that splits the emojis of the Matcher from the text
+// between them. See the package-level ScanEmojis.
+func (m *Matcher) ScanEmojis() bufio.SplitFunc {
+	t := &emojiTokenizer{m: m}
+	return t.split
+}
+
+// emojiTokenizer splits a stream into emoji and text tokens. Its offsets are relative to the beginning of
+// the data passed to the last call of split.
+type emojiTokenizer struct {
+	m *Matcher
+
+	// end is the end of the grapheme clusters already split into matches.
+	end int
+	// spans are the matches in the split clusters that have not been returned yet.
+	spans []span
+}
+
+type span struct {
+	start, end int
+}
+
+// maxTextToken is the length of text after which ScanEmojis returns a text token without waiting for the run to end.
+const maxTextToken = 4096
+
+func (t *emojiTokenizer) split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for len(t.spans) == 0 {
+		n, cluster, _ := scanGraphemes(data[t.end:], atEOF)
+		if n == 0 {
+			break
+		}
+
+		for _, match := range t.m.appendClusterMatches(nil, string(cluster), t.end, 0, 0) {
+			t.spans = append(t.spans, span{start: match.Start, end: match.End})
+		}
+		t.end += n
+	}
+
+	switch {
+	case len(t.spans) > 0 && t.spans[0].start > 0:
+		// The text before the next emoji.
+		return t.advance(data, t.spans[0].start)
+	case len(t.spans) > 0:
+		next := t.spans[0]
+		t.spans = t.spans[1:]
+		return t.advance(data, next.end)
+	case t.end > 0 && (atEOF || t.end >= maxTextToken):
+		// The text at the end of the input, or the beginning of a long run of text.
+		return t.advance(data, t.end)
+	default:
+		// The text may go on, so more data is requested.
+		return 0, nil, nil
+	}
+}
+
+// advance returns the first n bytes of data as a token and shifts the offsets past them.
+func (t *emojiTokenizer) advance(data []byte, n int) (int, []byte, error) {
+	t.end -= n
+	for i := range t.spans {
+		t.spans[i].start -= n
+		t.spans[i].end -= n
+	}
+
+	return n, data[:n], nil
+}
+
+// scanGraphemes is a split function for a bufio.Scanner that returns each grapheme cluster as a token.
+// A cluster is only returned once the rune following it is available, since that rune may extend it.
+func scanGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if len(data) == 0 || (!atEOF && !utf8.FullRune(data)) {
+		return 0, nil, nil
+	}
+
+	cluster, rest, _, _ := uniseg.FirstGraphemeCluster(data, -1)
+	if !atEOF && (len(rest) == 0 || !utf8.FullRune(rest)) {
+		return 0, nil, nil
+	}
+
+	return len(cluster), cluster, nil
+}
@@ -0,0 +1,164 @@
+package gomoji_test
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/forPelevin/gomoji"
+)
+
+var scannerInputs = []string{
+	"",
+	"hello world",
+	"hello 🦋 world \U0001F9FB",
+	"🆕️ NWT H&M Corduroy 🧻🦋🧻 Pants in 'Light Beige'🦋🆕",
+	"Family 👨‍👩‍👧‍👦 night 👍🏿",
+	"go 🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F!",
+	"🇺🇸🇦 🇦 🇧 🇨",
+	"🇦🇦🇺🇸",
+	"qwerty1️⃣ #⃣ Hello‼",
+	"a👩🏼‍🤝‍👨🏻‍👨🏾‍⚕b",
+}
+
+func TestScanner(t *testing.T) {
+	for _, input := range scannerInputs {
+		t.Run(input, func(t *testing.T) {
+			sc := gomoji.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+
+			var got []gomoji.Match
+			for sc.Scan() {
+				got = append(got, sc.Match())
+			}
+			if err := sc.Err(); err != nil {
+				t.Fatalf("Scanner.Err() = %v", err)
+			}
+
+			if want := gomoji.Matches(input); !reflect.DeepEqual(got, want) {
+				t.Errorf("Scanner matches = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestScanEmojis(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     []string
+	}{
+		{
+			name:     "string without emoji",
+			inputStr: "hello world",
+			want:     []string{"hello world"},
+		},
+		{
+			name:     "emojis and text",
+			inputStr: "🆕️ NWT 🧻🦋 pants",
+			want:     []string{"🆕️", " NWT ", "🧻", "🦋", " pants"},
+		},
+		{
+			name:     "zero width joiner sequence",
+			inputStr: "Family 👨‍👩‍👧‍👦",
+			want:     []string{"Family ", "👨‍👩‍👧‍👦"},
+		},
+		{
+			name:     "cluster longer than the emojis in it",
+			inputStr: "a👩🏼‍🤝‍👨🏻‍👨🏾‍⚕b",
+			want:     []string{"a", "👩", "🏼\u200d", "🤝", "\u200d", "👨", "🏻\u200d", "👨", "🏾\u200d", "⚕", "b"},
+		},
+		{
+			name:     "regional indicators are paired once",
+			inputStr: "🇦🇦🇺🇸",
+			want:     []string{"🇦", "🇦", "🇺🇸"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(tt.inputStr)))
+			sc.Split(gomoji.ScanEmojis())
+
+			var got []string
+			for sc.Scan() {
+				got = append(got, sc.Text())
+			}
+			if err := sc.Err(); err != nil {
+				t.Fatalf("Scanner.Err() = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanEmojis tokens = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanEmojisLongText(t *testing.T) {
+	text := strings.Repeat("hello ", 20000)
+	sc := bufio.NewScanner(strings.NewReader(text + "🦋"))
+	sc.Split(gomoji.ScanEmojis())
+
+	var got []string
+	for sc.Scan() {
+		if len(sc.Bytes()) > 2*4096 {
+			t.Errorf("ScanEmojis token length = %d", len(sc.Bytes()))
+		}
+		got = append(got, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("Scanner.Err() = %v", err)
+	}
+
+	if len(got) == 0 || got[len(got)-1] != "🦋" {
+		t.Fatalf("ScanEmojis last token = %q, want 🦋", got)
+	}
+	if joined := strings.Join(got[:len(got)-1], ""); joined != text {
+		t.Errorf("ScanEmojis text tokens join to %d bytes, want %d", len(joined), len(text))
+	}
+}
+
+func TestScanEmojisMatches(t *testing.T) {
+	for _, input := range scannerInputs {
+		t.Run(input, func(t *testing.T) {
+			sc := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+			sc.Split(gomoji.ScanEmojis())
+
+			var got []string
+			for sc.Scan() {
+				got = append(got, sc.Text())
+			}
+			if err := sc.Err(); err != nil {
+				t.Fatalf("Scanner.Err() = %v", err)
+			}
+
+			// The tokens are the matches and the text runs between them.
+			var want []string
+			prev := 0
+			for _, m := range gomoji.Matches(input) {
+				if m.Start > prev {
+					want = append(want, input[prev:m.Start])
+				}
+				want = append(want, m.Str)
+				prev = m.End
+			}
+			if prev < len(input) {
+				want = append(want, input[prev:])
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ScanEmojis tokens = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	input := strings.Repeat("\U0001F96F Hi \U0001F970", 100)
+	for i := 0; i < b.N; i++ {
+		sc := gomoji.NewScanner(strings.NewReader(input))
+		for sc.Scan() {
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package gomoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// curatedKeywords adds keywords for common emojis whose names miss the words people search for.
+// It complements the keywords derived from the names and is keyed by the canonical spelling.
+var curatedKeywords = map[string][]string{
+	"😀":  {"happy", "smile"},
+	"😂":  {"lol", "laugh", "happy"},
+	"🤣":  {"lol", "laugh", "rofl"},
+	"🙂":  {"smile", "happy"},
+	"😊":  {"blush", "happy", "smile"},
+	"😍":  {"love", "crush"},
+	"😘":  {"love", "kiss"},
+	"😎":  {"cool"},
+	"🥳":  {"party", "birthday", "celebrate"},
+	"🤔":  {"hmm", "think", "wonder"},
+	"😢":  {"sad", "tear", "cry"},
+	"😭":  {"sad", "sob", "cry"},
+	"😡":  {"angry", "mad", "rage"},
+	"😱":  {"scared", "scream", "shock"},
+	"💩":  {"poop", "crap", "shit"},
+	"❤️": {"love", "like"},
+	"💔":  {"sad", "breakup", "heartbreak"},
+	"👍":  {"like", "yes", "approve", "agree", "+1"},
+	"👎":  {"dislike", "no", "disapprove", "-1"},
+	"👌":  {"okay", "perfect"},
+	"👏":  {"applause", "bravo", "congrats"},
+	"🙏":  {"please", "thanks", "pray", "hope"},
+	"👋":  {"hello", "hi", "bye", "wave"},
+	"💪":  {"strong", "muscle", "gym"},
+	"🤝":  {"deal", "agreement", "meeting"},
+	"🤷":  {"shrug", "dunno", "whatever"},
+	"👀":  {"look", "watch", "see"},
+	"🎉":  {"party", "celebrate", "congrats", "tada"},
+	"🎂":  {"birthday", "party"},
+	"🔥":  {"hot", "lit", "flame"},
+	"💯":  {"perfect", "score", "100"},
+	"✅":  {"done", "yes", "ok", "check"},
+	"❌":  {"no", "wrong", "cancel"},
+	"⚠️": {"caution", "alert"},
+	"🚀":  {"launch", "ship", "space"},
+	"🏆":  {"win", "winner", "champion", "prize"},
+	"💰":  {"money", "rich", "dollar"},
+	"⭐":  {"favorite", "rating"},
+	"☀️": {"weather", "sunny"},
+	"🌧️": {"weather", "rainy"},
+	"☕":  {"coffee", "tea"},
+	"🍺":  {"beer", "drink", "pub"},
+	"🐶":  {"puppy", "pet"},
+	"🐱":  {"kitten", "pet"},
+	"⚽":  {"football", "sport"},
+	"🎵":  {"music", "song"},
+	"💤":  {"sleep", "tired"},
+}
+
+// keywordStopWords are the words of the names that are not used as keywords.
+var keywordStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "at": true, "for": true, "in": true,
+	"of": true, "on": true, "or": true, "the": true, "with": true,
+}
+
+// searchTable holds the searchable emojis of a Matcher with their lower-case names and keywords.
+type searchTable struct {
+	once    sync.Once
+	entries []searchEntry
+}
+
+type searchEntry struct {
+	emoji    Emoji
+	name     string
+	keywords []string
+}
+
+// Search match ranks, from the best to the worst.
+const (
+	rankExactName = iota
+	rankNamePrefix
+	rankKeyword
+	rankSubstring
+	rankNone
+)
+
+// Keywords returns the search keywords of the emoji: the words of its name and curated keywords for
+// common emojis, e.g. "grinning", "face", "happy" and "smile" for 😀. Toned emojis get the curated keywords
+// of the emoji without skin tones. The CLDR keywords are not bundled; see RegisterAnnotations and SearchIn.
+func Keywords(e Emoji) []string {
+	keywords := nameKeywords(e.Name)
+
+	base := WithoutSkinTone(e)
+	if canonical, ok := defaultMatcher.lookup(base.Character); ok {
+		base = canonical
+	}
+	for _, k := range curatedKeywords[base.Character] {
+		keywords = appendKeyword(keywords, k)
+	}
+
+	return keywords
+}
+
+// Search finds the emojis matching the query and returns up to limit of them, the best matches first.
+// The query is case-insensitive. An exact name match ranks first, followed by the emojis whose name starts
+// with the query, the emojis with the query as a keyword, and the emojis whose name or keywords contain it.
+// Matches of the same rank are ordered like AllEmojis. Every emoji is returned once in canonical spelling;
+// skin tone variants are left out and can be obtained with WithSkinTone. If limit is not positive,
+// all matches are returned. If nothing matches, it returns a nil-slice.
+func Search(query string, limit int) []Emoji {
+	return defaultMatcher.Search(query, limit)
+}
+
+// Search finds the emojis of the Matcher matching the query. See the package-level Search.
+func (m *Matcher) Search(query string, limit int) []Emoji {
+	return searchEntries(m.searches.get(m).entries, query, limit)
+}
+
+// searchEntries ranks the entries by how well they match the query and returns up to limit emojis. See Search.
+func searchEntries(entries []searchEntry, query string, limit int) []Emoji {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	type result struct {
+		emoji Emoji
+		rank  int
+	}
+
+	var results []result
+	for _, entry := range entries {
+		if rank := entry.rank(query); rank != rankNone {
+			results = append(results, result{emoji: entry.emoji, rank: rank})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].rank < results[j].rank
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	var emojis []Emoji
+	for _, r := range results {
+		emojis = append(emojis, r.emoji)
+	}
+
+	return emojis
+}
+
+// rank returns how well the entry matches the lower-case query.
+func (e searchEntry) rank(query string) int {
+	switch {
+	case e.name == query:
+		return rankExactName
+	case strings.HasPrefix(e.name, query):
+		return rankNamePrefix
+	}
+
+	for _, k := range e.keywords {
+		if k == query {
+			return rankKeyword
+		}
+	}
+
+	if strings.Contains(e.name, query) {
+		return rankSubstring
+	}
+	for _, k := range e.keywords {
+		if strings.Contains(k, query) {
+			return rankSubstring
+		}
+	}
+
+	return rankNone
+}
+
+func (t *searchTable) get(m *Matcher) *searchTable {
+	t.once.Do(func() {
+		t.build(m)
+	})
+	return t
+}
+
+func (t *searchTable) build(m *Matcher) {
+	for _, entry := range m.AllEntries() {
+		if entry.Status == StatusUnknown || strings.IndexFunc(entry.Character, isSkinToneModifier) >= 0 {
+			continue
+		}
+
+		t.entries = append(t.entries, searchEntry{
+			emoji:    entry.Emoji,
+			name:     strings.ToLower(entry.Name),
+			keywords: Keywords(entry.Emoji),
+		})
+	}
+}
+
+// nameKeywords splits the name of an emoji into lower-case words, leaving out stop words,
+// e.g. "face", "tears" and "joy" for "face with tears of joy".
+func nameKeywords(name string) []string {
+	var keywords []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !keywordStopWords[word] {
+			keywords = appendKeyword(keywords, word)
+		}
+	}
+
+	return keywords
+}
+
+// appendKeyword appends the keyword unless it is already in the keywords.
+func appendKeyword(keywords []string, keyword string) []string {
+	for _, k := range keywords {
+		if k == keyword {
+			return keywords
+		}
+	}
+
+	return append(keywords, keyword)
+}
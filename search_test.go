@@ -0,0 +1,119 @@
+package gomoji_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "exact name",
+			query: "pizza",
+			want:  []string{"🍕"},
+		},
+		{
+			name:  "exact name ranks before prefix",
+			query: "Red Heart",
+			limit: 1,
+			want:  []string{"❤️"},
+		},
+		{
+			name:  "name prefix",
+			query: "thumbs",
+			want:  []string{"👍", "👎"},
+		},
+		{
+			name:  "curated keyword",
+			query: "happy",
+			want:  []string{"😀", "😂", "🙂", "😊"},
+		},
+		{
+			name:  "name prefix ranks before keyword",
+			query: "lol",
+			want:  []string{"🍭", "🤣", "😂"},
+		},
+		{
+			name:  "limit",
+			query: "cat",
+			limit: 2,
+			want:  []string{"🐈", "😹"},
+		},
+		{
+			name:  "empty query",
+			query: " ",
+			want:  nil,
+		},
+		{
+			name:  "nothing found",
+			query: "qwerty",
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, em := range gomoji.Search(tt.query, tt.limit) {
+				got = append(got, em.Character)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchSkipsSkinTones(t *testing.T) {
+	for _, em := range gomoji.Search("thumbs up", 0) {
+		if len(gomoji.SkinTones(em)) > 0 {
+			t.Errorf("Search() returned toned emoji %q", em.Character)
+		}
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  []string
+	}{
+		{
+			name:  "name words without stop words",
+			emoji: "😂",
+			want:  []string{"face", "tears", "joy", "lol", "laugh", "happy"},
+		},
+		{
+			name:  "any spelling",
+			emoji: "❤",
+			want:  []string{"red", "heart", "love", "like"},
+		},
+		{
+			name:  "toned emoji",
+			emoji: "👋🏽",
+			want:  []string{"waving", "hand", "medium", "skin", "tone", "hello", "hi", "bye", "wave"},
+		},
+		{
+			name:  "no curated keywords",
+			emoji: "🦋",
+			want:  []string{"butterfly"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if got := gomoji.Keywords(em); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keywords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package gomoji
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:generate go run genshortcodes.go -output shortcode_github.go
+
+// Shortcode sets bundled with the package. GitHubShortcodes holds the aliases of gemoji, the emoji database
+// of GitHub. SlackStyleShortcodes and DiscordStyleShortcodes add a partial list of the aliases in which Slack
+// and Discord differ from GitHub, so they do not cover every alias of these platforms. SlackStyleShortcodes
+// also reads and writes skin tones the way Slack does, e.g. :+1::skin-tone-2: for 👍🏻. Every emoji that has
+// no alias in a set gets one derived from its slug, e.g. :face_with_bags_under_eyes:.
+var (
+	GitHubShortcodes       = newShortcodeSet(githubShortcodes)
+	SlackStyleShortcodes   = newShortcodeSet(githubShortcodes, slackShortcodes).withSkinToneSuffixes()
+	DiscordStyleShortcodes = newShortcodeSet(githubShortcodes, discordShortcodes)
+)
+
+// ShortcodeSet maps emojis to shortcode aliases such as :tada: or :+1: and back.
+type ShortcodeSet struct {
+	open, close string
+	idx         *shortcodeIndex
+
+	// skinTones enables the Slack skin tone suffixes such as :skin-tone-2:.
+	skinTones bool
+}
+
+type shortcodeIndex struct {
+	once    sync.Once
+	layers  []map[string][]string
+	byAlias map[string]string
+	byEmoji map[string][]string
+
+	completions completionIndex
+}
+
+func newShortcodeSet(layers ...map[string][]string) *ShortcodeSet {
+	return &ShortcodeSet{
+		open:  ":",
+		close: ":",
+		idx:   &shortcodeIndex{layers: layers},
+	}
+}
+
+// withSkinToneSuffixes makes the set write a toned emoji as the alias of the emoji without skin tones followed by
+// a skin tone suffix, from :skin-tone-2: for the light skin tone to :skin-tone-6: for the dark one.
+func (set *ShortcodeSet) withSkinToneSuffixes() *ShortcodeSet {
+	set.skinTones = true
+	return set
+}
+
+// Emojize replaces all GitHub shortcodes such as :tada: in the s string with emojis and returns a new string.
+func Emojize(s string) string {
+	return GitHubShortcodes.Emojize(s)
+}
+
+// Demojize replaces all emojis in the s string with GitHub shortcodes such as :tada: and returns a new string.
+func Demojize(s string) string {
+	return GitHubShortcodes.Demojize(s)
+}
+
+// WithDelimiters returns a copy of the set that wraps shortcodes into the open and close delimiters instead of colons.
+func (set *ShortcodeSet) WithDelimiters(open, close string) *ShortcodeSet {
+	return &ShortcodeSet{
+		open:      open,
+		close:     close,
+		idx:       set.idx,
+		skinTones: set.skinTones,
+	}
+}
+
+// Aliases returns all shortcodes of the emoji without delimiters, preferred one first.
+func (set *ShortcodeSet) Aliases(em Emoji) []string {
+	aliases := set.index().byEmoji[withoutVariationSelectors(em.Character)]
+
+	return append([]string(nil), aliases...)
+}
+
+// Lookup returns the emoji the alias stands for. The alias may be given with or without delimiters.
+// If the alias is unknown, it returns the gomoji.ErrUnknownShortcode error.
+func (set *ShortcodeSet) Lookup(alias string) (Emoji, error) {
+	alias = strings.TrimSuffix(strings.TrimPrefix(alias, set.open), set.close)
+
+	character, ok := set.index().byAlias[alias]
+	if !ok {
+		return Emoji{}, ErrUnknownShortcode
+	}
+
+	return emojiMap[character], nil
+}
+
+// Emojize replaces all shortcodes of the set in the s string with emojis and returns a new string.
+// Delimited words which are not known shortcodes are left untouched.
+func (set *ShortcodeSet) Emojize(s string) string {
+	byAlias := set.index().byAlias
+
+	return replaceDelimited(s, set.open, set.close, func(alias, rest string) (string, int, bool) {
+		character, ok := byAlias[alias]
+		if !ok {
+			return "", 0, false
+		}
+
+		if tone, n := set.skinToneSuffix(rest); n > 0 {
+			if toned, err := WithSkinTone(emojiMap[character], tone); err == nil {
+				return toned.Character, n, true
+			}
+		}
+
+		return character, 0, true
+	})
+}
+
+// Demojize replaces all emojis in the s string with their preferred shortcode of the set and returns a new string.
+// Like ReplaceEmojisWithFunc, it strips variation selectors from the result.
+func (set *ShortcodeSet) Demojize(s string) string {
+	byEmoji := set.index().byEmoji
+
+	return ReplaceEmojisWithFunc(s, func(em Emoji) string {
+		if tones := SkinTones(em); set.skinTones && len(tones) == 1 {
+			if aliases := byEmoji[withoutVariationSelectors(WithoutSkinTone(em).Character)]; len(aliases) > 0 {
+				return set.open + aliases[0] + set.close + set.skinToneAlias(tones[0])
+			}
+		}
+
+		aliases := byEmoji[withoutVariationSelectors(em.Character)]
+		if len(aliases) == 0 {
+			return em.Character
+		}
+
+		return set.open + aliases[0] + set.close
+	})
+}
+
+// skinToneAlias returns the delimited skin tone suffix of the tone, e.g. :skin-tone-2: for SkinToneLight.
+func (set *ShortcodeSet) skinToneAlias(tone SkinTone) string {
+	return set.open + "skin-tone-" + strconv.Itoa(int(tone)+1) + set.close
+}
+
+// skinToneSuffix returns the skin tone of the suffix at the beginning of the rest and its length.
+// It returns a zero length if the set has no skin tone suffixes or the rest does not start with one.
+func (set *ShortcodeSet) skinToneSuffix(rest string) (SkinTone, int) {
+	if !set.skinTones {
+		return SkinToneNone, 0
+	}
+
+	for tone := SkinToneLight; tone <= SkinToneDark; tone++ {
+		if suffix := set.skinToneAlias(tone); strings.HasPrefix(rest, suffix) {
+			return tone, len(suffix)
+		}
+	}
+
+	return SkinToneNone, 0
+}
+
+func (set *ShortcodeSet) index() *shortcodeIndex {
+	set.idx.once.Do(set.idx.build)
+	return set.idx
+}
+
+func (idx *shortcodeIndex) build() {
+	explicit := make(map[string][]string)
+	for _, layer := range idx.layers {
+		for character, aliases := range layer {
+			explicit[character] = aliases
+		}
+	}
+
+	idx.byAlias = make(map[string]string)
+	idx.byEmoji = make(map[string][]string)
+
+	for character, aliases := range explicit {
+		key := withoutVariationSelectors(character)
+		for _, alias := range aliases {
+			idx.byAlias[alias] = character
+			idx.byEmoji[key] = append(idx.byEmoji[key], alias)
+		}
+	}
+
+	for _, character := range defaultMatcher.preferredSpellings() {
+		key := withoutVariationSelectors(character)
+		if len(idx.byEmoji[key]) > 0 {
+			continue
+		}
+
+		alias := slugToAlias(emojiMap[character].Slug)
+		if _, ok := idx.byAlias[alias]; ok || alias == "" {
+			continue
+		}
+
+		idx.byAlias[alias] = character
+		idx.byEmoji[key] = append(idx.byEmoji[key], alias)
+	}
+}
+
+// replaceDelimited replaces every word wrapped into the open and close delimiters in the s string
+// with the result of the lookup function. Words the lookup function does not know are left untouched.
+// The lookup function gets the rest of the string after the word and may replace the first n bytes of it as well.
+func replaceDelimited(s, open, close string, lookup func(word, rest string) (replacement string, n int, ok bool)) string {
+	var buf strings.Builder
+	for {
+		i := strings.Index(s, open)
+		if i < 0 {
+			break
+		}
+
+		rest := s[i+len(open):]
+		j := strings.Index(rest, close)
+		if j < 0 {
+			break
+		}
+
+		if replacement, n, ok := lookup(rest[:j], rest[j+len(close):]); ok {
+			buf.WriteString(s[:i])
+			buf.WriteString(replacement)
+			s = rest[j+len(close)+n:]
+			continue
+		}
+
+		buf.WriteString(s[:i+len(open)])
+		s = rest
+	}
+	buf.WriteString(s)
+
+	return buf.String()
+}
+
+// slugToAlias turns a slug into a shortcode alias, e.g. "family-man,-woman,-girl,-boy" into "family_man_woman_girl_boy".
+func slugToAlias(slug string) string {
+	var buf strings.Builder
+	sep := false
+	for _, r := range strings.ToLower(slug) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sep = buf.Len() > 0
+			continue
+		}
+
+		if sep {
+			buf.WriteByte('_')
+			sep = false
+		}
+		buf.WriteRune(r)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,86 @@
+package gomoji
+
+// slackShortcodes lists common aliases that differ between Slack and GitHub, preferred alias first.
+// The list is partial and maintained by hand.
+var slackShortcodes = map[string][]string{
+	"🙂":  {"slightly_smiling_face", "simple_smile"},
+	"🤗":  {"hugging_face"},
+	"🙄":  {"face_with_rolling_eyes"},
+	"🤐":  {"zipper_mouth_face"},
+	"🤒":  {"face_with_thermometer"},
+	"🤓":  {"nerd_face"},
+	"🤣":  {"rolling_on_the_floor_laughing", "rofl"},
+	"🤩":  {"star-struck", "grinning_face_with_star_eyes"},
+	"🤪":  {"zany_face", "grinning_face_with_one_large_and_one_small_eye"},
+	"🤬":  {"face_with_symbols_on_mouth", "serious_face_with_symbols_covering_mouth"},
+	"🤮":  {"face_vomiting", "face_with_open_mouth_vomiting"},
+	"🤭":  {"face_with_hand_over_mouth", "smiling_face_with_smiling_eyes_and_hand_covering_mouth"},
+	"🧐":  {"face_with_monocle"},
+	"👍":  {"+1", "thumbsup"},
+	"👎":  {"-1", "thumbsdown"},
+	"✊":  {"fist"},
+	"👊":  {"facepunch", "punch"},
+	"🤘":  {"the_horns", "sign_of_the_horns"},
+	"🖕":  {"middle_finger", "reversed_hand_with_middle_finger_extended"},
+	"💩":  {"hankey", "poop", "shit"},
+	"🏃":  {"runner", "running"},
+	"🦊":  {"fox_face"},
+	"🥇":  {"first_place_medal"},
+	"🔵":  {"large_blue_circle"},
+	"⚠️": {"warning"},
+	"🇬🇧": {"flag-gb", "gb", "uk"},
+	"🇺🇸": {"flag-us", "us"},
+	"🇩🇪": {"flag-de", "de"},
+	"🇫🇷": {"flag-fr", "fr"},
+	"🇯🇵": {"flag-jp", "jp"},
+	"🇨🇳": {"flag-cn", "cn"},
+	"🇷🇺": {"flag-ru", "ru"},
+	"🇮🇹": {"flag-it", "it"},
+	"🇪🇸": {"flag-es", "es"},
+	"🇰🇷": {"flag-kr", "kr"},
+}
+
+// discordShortcodes lists common aliases that differ between Discord and GitHub, preferred alias first.
+// The list is partial and maintained by hand.
+var discordShortcodes = map[string][]string{
+	"🙂":  {"slight_smile", "slightly_smiling_face"},
+	"🙃":  {"upside_down", "upside_down_face"},
+	"🙁":  {"slight_frown", "slightly_frowning_face"},
+	"🤗":  {"hugging", "hugging_face"},
+	"🙄":  {"rolling_eyes", "face_with_rolling_eyes"},
+	"🤐":  {"zipper_mouth", "zipper_mouth_face"},
+	"🤒":  {"thermometer_face", "face_with_thermometer"},
+	"🤕":  {"head_bandage", "face_with_head_bandage"},
+	"🤓":  {"nerd", "nerd_face"},
+	"🤑":  {"money_mouth", "money_mouth_face"},
+	"🤠":  {"cowboy", "face_with_cowboy_hat"},
+	"🤡":  {"clown", "clown_face"},
+	"🤢":  {"nauseated_face", "sick"},
+	"🤣":  {"rofl", "rolling_on_the_floor_laughing"},
+	"🤩":  {"star_struck"},
+	"🤬":  {"face_with_symbols_over_mouth"},
+	"🤮":  {"face_vomiting"},
+	"🤭":  {"face_with_hand_over_mouth"},
+	"🧐":  {"face_with_monocle"},
+	"👍":  {"thumbsup", "+1", "thumbup"},
+	"👎":  {"thumbsdown", "-1", "thumbdown"},
+	"🤘":  {"metal", "sign_of_the_horns"},
+	"🖕":  {"middle_finger", "reversed_hand_with_middle_finger_extended"},
+	"🤞":  {"fingers_crossed", "hand_with_index_and_middle_finger_crossed"},
+	"🤙":  {"call_me", "call_me_hand"},
+	"💩":  {"poop", "shit", "hankey", "poo"},
+	"🏃":  {"person_running", "runner"},
+	"🦊":  {"fox", "fox_face"},
+	"🥇":  {"first_place", "first_place_medal"},
+	"🔵":  {"blue_circle"},
+	"🇬🇧": {"flag_gb"},
+	"🇺🇸": {"flag_us"},
+	"🇩🇪": {"flag_de"},
+	"🇫🇷": {"flag_fr"},
+	"🇯🇵": {"flag_jp"},
+	"🇨🇳": {"flag_cn"},
+	"🇷🇺": {"flag_ru"},
+	"🇮🇹": {"flag_it"},
+	"🇪🇸": {"flag_es"},
+	"🇰🇷": {"flag_kr"},
+}
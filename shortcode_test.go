@@ -0,0 +1,206 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestEmojize(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *gomoji.ShortcodeSet
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "string without shortcodes",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "hello world",
+			want:     "hello world",
+		},
+		{
+			name:     "github aliases",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "Released :tada: :+1::thumbsup:",
+			want:     "Released 🎉 👍👍",
+		},
+		{
+			name:     "fully-qualified emoji is produced",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "I :heart: you",
+			want:     "I ❤️ you",
+		},
+		{
+			name:     "alias derived from the slug",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: ":face_with_bags_under_eyes: tired",
+			want:     "🫩 tired",
+		},
+		{
+			name:     "gemoji flag aliases",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: ":england: :scotland: :wales:",
+			want:     "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F 🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F 🏴\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F",
+		},
+		{
+			name:     "unknown shortcodes and colons remain",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "at 10:30 :not_an_emoji: :tada:",
+			want:     "at 10:30 :not_an_emoji: 🎉",
+		},
+		{
+			name:     "slack aliases",
+			set:      gomoji.SlackStyleShortcodes,
+			inputStr: ":simple_smile: :flag-us:",
+			want:     "🙂 🇺🇸",
+		},
+		{
+			name:     "slack skin tones",
+			set:      gomoji.SlackStyleShortcodes,
+			inputStr: ":+1::skin-tone-2: :thumbsup::skin-tone-6: :tada::skin-tone-3: :+1: :skin-tone-2:",
+			want:     "👍🏻 👍🏿 🎉:skin-tone-3: 👍 :skin-tone-2:",
+		},
+		{
+			name:     "skin tone suffixes are slack only",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: ":+1::skin-tone-2:",
+			want:     "👍:skin-tone-2:",
+		},
+		{
+			name:     "discord aliases",
+			set:      gomoji.DiscordStyleShortcodes,
+			inputStr: ":slight_smile: :flag_us:",
+			want:     "🙂 🇺🇸",
+		},
+		{
+			name:     "custom delimiters",
+			set:      gomoji.GitHubShortcodes.WithDelimiters("{{", "}}"),
+			inputStr: "{{tada}} :tada:",
+			want:     "🎉 :tada:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Emojize(tt.inputStr); got != tt.want {
+				t.Errorf("Emojize() = \"%v\", want \"%v\"", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDemojize(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      *gomoji.ShortcodeSet
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "string without emoji",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "hello world",
+			want:     "hello world",
+		},
+		{
+			name:     "preferred github aliases",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "Released 🎉 👍 I ❤️ you",
+			want:     "Released :tada: :+1: I :heart: you",
+		},
+		{
+			name:     "alias derived from the slug",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "🫩 tired",
+			want:     ":face_with_bags_under_eyes: tired",
+		},
+		{
+			name:     "gemoji flag aliases",
+			set:      gomoji.GitHubShortcodes,
+			inputStr: "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F",
+			want:     ":england:",
+		},
+		{
+			name:     "slack skin tones",
+			set:      gomoji.SlackStyleShortcodes,
+			inputStr: "👍🏻 🤝🏻 👍",
+			want:     ":+1::skin-tone-2: :handshake::skin-tone-2: :+1:",
+		},
+		{
+			name:     "discord aliases",
+			set:      gomoji.DiscordStyleShortcodes,
+			inputStr: "👍",
+			want:     ":thumbsup:",
+		},
+		{
+			name:     "custom delimiters",
+			set:      gomoji.SlackStyleShortcodes.WithDelimiters("[", "]"),
+			inputStr: "🇺🇸",
+			want:     "[flag-us]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.Demojize(tt.inputStr); got != tt.want {
+				t.Errorf("Demojize() = \"%v\", want \"%v\"", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortcodeSetLookup(t *testing.T) {
+	em, err := gomoji.GitHubShortcodes.Lookup(":butterfly:")
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if em.Slug != "butterfly" {
+		t.Errorf("Lookup() = %v, want butterfly", em.Slug)
+	}
+
+	if _, err := gomoji.GitHubShortcodes.Lookup("not_an_emoji"); !errors.Is(err, gomoji.ErrUnknownShortcode) {
+		t.Errorf("Lookup() error = %v, want %v", err, gomoji.ErrUnknownShortcode)
+	}
+}
+
+func TestShortcodeSetAliases(t *testing.T) {
+	tada, _ := gomoji.GetInfo("🎉")
+	if got := gomoji.GitHubShortcodes.Aliases(tada); !reflect.DeepEqual(got, []string{"tada"}) {
+		t.Errorf("Aliases() = %q, want [tada]", got)
+	}
+
+	// Emojis with an explicit alias do not get one derived from the slug.
+	for _, alias := range []string{"party_popper", "thumbs_up", "red_heart"} {
+		if _, err := gomoji.GitHubShortcodes.Lookup(alias); !errors.Is(err, gomoji.ErrUnknownShortcode) {
+			t.Errorf("Lookup(%q) error = %v, want %v", alias, err, gomoji.ErrUnknownShortcode)
+		}
+	}
+}
+
+func TestShortcodeSetsRoundTrip(t *testing.T) {
+	for name, set := range map[string]*gomoji.ShortcodeSet{
+		"github":  gomoji.GitHubShortcodes,
+		"slack":   gomoji.SlackStyleShortcodes,
+		"discord": gomoji.DiscordStyleShortcodes,
+	} {
+		for _, em := range gomoji.AllEmojis() {
+			for _, alias := range set.Aliases(em) {
+				got, err := set.Lookup(alias)
+				if err != nil {
+					t.Fatalf("%s: Lookup(%q) error = %v", name, alias, err)
+				}
+				// Spellings that differ only in variation selectors share their aliases.
+				if gomoji.ReplaceEmojisWithSlug(got.Character) != gomoji.ReplaceEmojisWithSlug(em.Character) {
+					t.Errorf("%s: Lookup(%q) = %v, want %v", name, alias, got.Character, em.Character)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDemojize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gomoji.Demojize("🧖 hello 🦋world")
+	}
+}
@@ -0,0 +1,196 @@
+package gomoji
+
+import (
+	"strings"
+	"sync"
+)
+
+// SkinTone is one of the five Fitzpatrick skin tone modifiers U+1F3FB..U+1F3FF.
+type SkinTone uint8
+
+// Skin tones. SkinToneNone stands for the default yellow tone without a modifier.
+const (
+	SkinToneNone SkinTone = iota
+	SkinToneLight
+	SkinToneMediumLight
+	SkinToneMedium
+	SkinToneMediumDark
+	SkinToneDark
+)
+
+const firstSkinToneModifier = '\U0001F3FB'
+
+var skinToneNames = [...]string{
+	SkinToneNone:        "",
+	SkinToneLight:       "light skin tone",
+	SkinToneMediumLight: "medium-light skin tone",
+	SkinToneMedium:      "medium skin tone",
+	SkinToneMediumDark:  "medium-dark skin tone",
+	SkinToneDark:        "dark skin tone",
+}
+
+var skinTones skinToneTable
+
+// skinToneTable maps every toneless emoji followed by the modifiers of a toned variant to that variant.
+type skinToneTable struct {
+	once    sync.Once
+	byTones map[string]string
+}
+
+// String returns the CLDR name of the skin tone, e.g. "medium-dark skin tone".
+func (t SkinTone) String() string {
+	if int(t) >= len(skinToneNames) {
+		return ""
+	}
+
+	return skinToneNames[t]
+}
+
+// Modifier returns the skin tone modifier code point, e.g. U+1F3FB for SkinToneLight.
+// It returns 0 for SkinToneNone and unknown skin tones.
+func (t SkinTone) Modifier() rune {
+	if t == SkinToneNone || int(t) >= len(skinToneNames) {
+		return 0
+	}
+
+	return firstSkinToneModifier + rune(t) - 1
+}
+
+// SkinTones returns the skin tones of the emoji in order of appearance. Sequences of two people,
+// such as handshakes and couples, may have two skin tones. If the emoji has no skin tone it returns a nil-slice.
+func SkinTones(e Emoji) []SkinTone {
+	var tones []SkinTone
+	for _, r := range e.Character {
+		if isSkinToneModifier(r) {
+			tones = append(tones, SkinTone(r-firstSkinToneModifier+1))
+		}
+	}
+
+	return tones
+}
+
+// WithoutSkinTone returns the emoji without skin tones, e.g. 👍 for 👍🏿, or 💑 for 🧑🏻‍❤️‍🧑🏿.
+// The result is the canonical spelling. The emoji is returned as is if it has no skin tone.
+func WithoutSkinTone(e Emoji) Emoji {
+	if strings.IndexFunc(e.Character, isSkinToneModifier) < 0 {
+		return e
+	}
+
+	if base, ok := defaultMatcher.lookup(strings.Map(dropSkinToneModifier, e.Character)); ok {
+		return base
+	}
+	if base, ok := baseByName(e.Name); ok {
+		return base
+	}
+
+	return e
+}
+
+// WithSkinTone returns the variant of the emoji with the given skin tones, replacing the ones it has.
+// Sequences of two people take one skin tone for both or one per person. Without skin tones, it returns
+// the emoji without skin tones. If the emoji has no such variant, it returns the gomoji.ErrUnsupportedSkinTone error.
+func WithSkinTone(e Emoji, tones ...SkinTone) (Emoji, error) {
+	base := WithoutSkinTone(e)
+	if len(tones) == 0 {
+		return base, nil
+	}
+
+	modifiers := make([]rune, 0, len(tones))
+	for _, tone := range tones {
+		r := tone.Modifier()
+		if r == 0 {
+			return Emoji{}, ErrUnsupportedSkinTone
+		}
+		modifiers = append(modifiers, r)
+	}
+
+	byTones := skinTones.get().byTones
+	for _, key := range skinToneKeys(base.Character, modifiers) {
+		if character, ok := byTones[key]; ok {
+			return emojiMap[character], nil
+		}
+	}
+
+	return Emoji{}, ErrUnsupportedSkinTone
+}
+
+// skinToneKeys returns the keys of the skin tone table to try for the modifiers. A single skin tone
+// of a two-person sequence is spelled twice, and two equal ones are spelled once in some sequences.
+func skinToneKeys(base string, modifiers []rune) []string {
+	keys := []string{base + string(modifiers)}
+
+	switch {
+	case len(modifiers) == 1:
+		keys = append(keys, base+string(modifiers)+string(modifiers))
+	case len(modifiers) == 2 && modifiers[0] == modifiers[1]:
+		keys = append(keys, base+string(modifiers[:1]))
+	}
+
+	return keys
+}
+
+func (t *skinToneTable) get() *skinToneTable {
+	t.once.Do(t.build)
+	return t
+}
+
+func (t *skinToneTable) build() {
+	t.byTones = make(map[string]string)
+	for character, em := range emojiMap {
+		if em.Status == StatusUnknown {
+			continue
+		}
+
+		var modifiers []rune
+		for _, r := range character {
+			if isSkinToneModifier(r) {
+				modifiers = append(modifiers, r)
+			}
+		}
+		if len(modifiers) == 0 {
+			continue
+		}
+
+		key := WithoutSkinTone(em).Character + string(modifiers)
+		if cur, ok := t.byTones[key]; !ok || preferSpelling(emojiMap, character, cur) {
+			t.byTones[key] = character
+		}
+	}
+}
+
+// baseByName finds the emoji without skin tones by the name of a toned one. The names of toned emojis
+// list the skin tones after a colon, e.g. "kiss: woman, man, light skin tone, dark skin tone".
+func baseByName(name string) (Emoji, bool) {
+	base, list, ok := strings.Cut(name, ": ")
+	if !ok {
+		return Emoji{}, false
+	}
+
+	var kept []string
+	for _, item := range strings.Split(list, ", ") {
+		if !strings.HasSuffix(item, "skin tone") {
+			kept = append(kept, item)
+		}
+	}
+
+	names := []string{base}
+	if len(kept) > 0 {
+		// Mixed tone couples list the people, e.g. "person, person", while the toneless emoji does not.
+		names = []string{base + ": " + strings.Join(kept, ", "), base}
+	}
+
+	for _, n := range names {
+		if found, err := GetByName(n); err == nil {
+			return found[0], true
+		}
+	}
+
+	return Emoji{}, false
+}
+
+func dropSkinToneModifier(r rune) rune {
+	if isSkinToneModifier(r) {
+		return -1
+	}
+	return r
+}
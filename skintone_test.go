@@ -0,0 +1,229 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestSkinTones(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  []gomoji.SkinTone
+	}{
+		{
+			name:  "no skin tone",
+			emoji: "👍",
+			want:  nil,
+		},
+		{
+			name:  "single skin tone",
+			emoji: "👍🏿",
+			want:  []gomoji.SkinTone{gomoji.SkinToneDark},
+		},
+		{
+			name:  "zwj sequence",
+			emoji: "🏃🏽‍♀️‍➡️",
+			want:  []gomoji.SkinTone{gomoji.SkinToneMedium},
+		},
+		{
+			name:  "two people",
+			emoji: "🧑🏻‍❤️‍🧑🏿",
+			want:  []gomoji.SkinTone{gomoji.SkinToneLight, gomoji.SkinToneDark},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if got := gomoji.SkinTones(em); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SkinTones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutSkinTone(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  string
+	}{
+		{
+			name:  "no skin tone",
+			emoji: "👍",
+			want:  "👍",
+		},
+		{
+			name:  "single skin tone",
+			emoji: "👍🏿",
+			want:  "👍",
+		},
+		{
+			name:  "zwj sequence",
+			emoji: "🏃🏽‍♀️‍➡️",
+			want:  "🏃‍♀️‍➡️",
+		},
+		{
+			name:  "minimally qualified zwj sequence",
+			emoji: "🏃🏽‍♀‍➡️",
+			want:  "🏃‍♀️‍➡️",
+		},
+		{
+			name:  "handshake with two skin tones",
+			emoji: "🫱🏻‍🫲🏿",
+			want:  "🤝",
+		},
+		{
+			name:  "couple of people with two skin tones",
+			emoji: "🧑🏻‍❤️‍🧑🏿",
+			want:  "💑",
+		},
+		{
+			name:  "couple of woman and man",
+			emoji: "👩🏻‍❤️‍💋‍👨🏿",
+			want:  "👩‍❤️‍💋‍👨",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if got := gomoji.WithoutSkinTone(em); got.Character != tt.want {
+				t.Errorf("WithoutSkinTone() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithSkinTone(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		tones   []gomoji.SkinTone
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "single skin tone",
+			emoji: "👍",
+			tones: []gomoji.SkinTone{gomoji.SkinToneDark},
+			want:  "👍🏿",
+		},
+		{
+			name:  "replace skin tone",
+			emoji: "👍🏻",
+			tones: []gomoji.SkinTone{gomoji.SkinToneMedium},
+			want:  "👍🏽",
+		},
+		{
+			name:  "no skin tones",
+			emoji: "👍🏻",
+			want:  "👍",
+		},
+		{
+			name:  "zwj sequence",
+			emoji: "🏃‍♀️‍➡️",
+			tones: []gomoji.SkinTone{gomoji.SkinToneMediumDark},
+			want:  "🏃🏾‍♀️‍➡️",
+		},
+		{
+			name:  "handshake with one skin tone",
+			emoji: "🤝",
+			tones: []gomoji.SkinTone{gomoji.SkinToneLight},
+			want:  "🤝🏻",
+		},
+		{
+			name:  "handshake with two skin tones",
+			emoji: "🤝",
+			tones: []gomoji.SkinTone{gomoji.SkinToneLight, gomoji.SkinToneDark},
+			want:  "🫱🏻‍🫲🏿",
+		},
+		{
+			name:  "handshake with two equal skin tones",
+			emoji: "🤝",
+			tones: []gomoji.SkinTone{gomoji.SkinToneLight, gomoji.SkinToneLight},
+			want:  "🤝🏻",
+		},
+		{
+			name:  "people holding hands with one skin tone",
+			emoji: "🧑‍🤝‍🧑",
+			tones: []gomoji.SkinTone{gomoji.SkinToneMedium},
+			want:  "🧑🏽‍🤝‍🧑🏽",
+		},
+		{
+			name:  "couple with two skin tones",
+			emoji: "💑",
+			tones: []gomoji.SkinTone{gomoji.SkinToneLight, gomoji.SkinToneDark},
+			want:  "🧑🏻‍❤️‍🧑🏿",
+		},
+		{
+			name:    "emoji without skin tones",
+			emoji:   "🦋",
+			tones:   []gomoji.SkinTone{gomoji.SkinToneDark},
+			wantErr: gomoji.ErrUnsupportedSkinTone,
+		},
+		{
+			name:    "too many skin tones",
+			emoji:   "👍",
+			tones:   []gomoji.SkinTone{gomoji.SkinToneLight, gomoji.SkinToneDark},
+			wantErr: gomoji.ErrUnsupportedSkinTone,
+		},
+		{
+			name:    "invalid skin tone",
+			emoji:   "👍",
+			tones:   []gomoji.SkinTone{gomoji.SkinToneNone},
+			wantErr: gomoji.ErrUnsupportedSkinTone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+
+			got, err := gomoji.WithSkinTone(em, tt.tones...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WithSkinTone() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Character != tt.want {
+				t.Errorf("WithSkinTone() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkinToneRoundTrip(t *testing.T) {
+	for _, em := range gomoji.AllEmojis() {
+		tones := gomoji.SkinTones(em)
+		if len(tones) == 0 || em.Status != gomoji.StatusFullyQualified {
+			continue
+		}
+
+		got, err := gomoji.WithSkinTone(gomoji.WithoutSkinTone(em), tones...)
+		if err != nil {
+			t.Errorf("WithSkinTone(%q, %v) error = %v", em.Character, tones, err)
+			continue
+		}
+		if got.Character != em.Character {
+			t.Errorf("WithSkinTone(%q, %v) = %q", em.Character, tones, got.Character)
+		}
+	}
+}
+
+func TestSkinToneString(t *testing.T) {
+	if got, want := gomoji.SkinToneMediumDark.String(), "medium-dark skin tone"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got, want := gomoji.SkinToneDark.Modifier(), '\U0001F3FF'; got != want {
+		t.Errorf("Modifier() = %U, want %U", got, want)
+	}
+}
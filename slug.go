@@ -0,0 +1,158 @@
+package gomoji
+
+import (
+	"strings"
+	"sync"
+)
+
+// slugVariantSep separates the slug from the variation selectors of the spelling in encoded slugs, e.g. :keycap-#~00:.
+const slugVariantSep = "~"
+
+// SlugEncoding is a reversible encoding of emojis into delimited slugs such as :butterfly:.
+// Spellings of an emoji that differ only in variation selectors share the slug. The preferred spelling is encoded
+// as the slug alone, and any other one carries a digit per code point after a tilde, 1 if the code point is followed
+// by U+FE0F and 0 otherwise, e.g. :keycap-#: for #️⃣ and :keycap-#~00: for #⃣. The digits spell out the code points,
+// so encoded strings decode to the same characters after updates of the emoji list.
+//
+// Delimited slugs already in the input are not escaped: Decode turns a literal :butterfly: into 🦋 as well.
+type SlugEncoding struct {
+	Open  string
+	Close string
+}
+
+// DefaultSlugEncoding wraps slugs into colons.
+var DefaultSlugEncoding = SlugEncoding{Open: ":", Close: ":"}
+
+var slugs slugTable
+
+// slugTable maps every spelling in the dataset to its encoded slug, and every slug to the preferred spelling.
+type slugTable struct {
+	once        sync.Once
+	byCharacter map[string]string
+	bySlug      map[string]string
+}
+
+// ReplaceEmojisWithDelimitedSlug replaces all emojis from the s string with their slug wrapped into colons
+// and returns a new string. Unlike ReplaceEmojisWithSlug, the result can be restored with ReplaceSlugsWithEmojis.
+func ReplaceEmojisWithDelimitedSlug(s string) string {
+	return DefaultSlugEncoding.Encode(s)
+}
+
+// ReplaceSlugsWithEmojis replaces all slugs wrapped into colons in the s string with the emojis they
+// stand for and returns a new string. It restores the exact spelling ReplaceEmojisWithDelimitedSlug encoded.
+func ReplaceSlugsWithEmojis(s string) string {
+	return DefaultSlugEncoding.Decode(s)
+}
+
+// Encode replaces all emojis from the s string with their delimited slug and returns a new string.
+// Unlike ReplaceEmojisWithFunc, it keeps variation selectors, so Decode restores the s string exactly.
+// Spellings missing from the dataset, e.g. with a text presentation selector, are left as is.
+func (enc SlugEncoding) Encode(s string) string {
+	byCharacter := slugs.get().byCharacter
+
+	var buf strings.Builder
+	var pos int
+	for _, m := range Matches(s) {
+		buf.WriteString(s[pos:m.Start])
+		pos = m.End
+
+		// Matches resolve to the canonical spelling, so the exact one is looked up first.
+		token, ok := byCharacter[m.Str]
+		rest := ""
+		if !ok && strings.HasPrefix(m.Str, m.Emoji.Character) {
+			token, rest = byCharacter[m.Emoji.Character], m.Str[len(m.Emoji.Character):]
+		} else if !ok {
+			buf.WriteString(m.Str)
+			continue
+		}
+
+		buf.WriteString(enc.Open)
+		buf.WriteString(token)
+		buf.WriteString(enc.Close)
+		buf.WriteString(rest)
+	}
+	buf.WriteString(s[pos:])
+
+	return buf.String()
+}
+
+// Decode replaces all delimited slugs in the s string with the emojis they stand for and returns a new string.
+// Delimited words which are not slugs are left untouched.
+func (enc SlugEncoding) Decode(s string) string {
+	bySlug := slugs.get().bySlug
+
+	return replaceDelimited(s, enc.Open, enc.Close, func(token, _ string) (string, int, bool) {
+		slug, selectors, hasSelectors := strings.Cut(token, slugVariantSep)
+		character, ok := bySlug[slug]
+		if !ok || !hasSelectors {
+			return character, 0, ok
+		}
+
+		character, ok = withVariationSelectors(withoutVariationSelectors(character), selectors)
+		return character, 0, ok
+	})
+}
+
+// variationSelectorDigits returns a digit per code point of the spelling other than variation selectors,
+// 1 if the code point is followed by U+FE0F and 0 otherwise.
+func variationSelectorDigits(spelling string) string {
+	var digits []byte
+	for _, r := range spelling {
+		switch {
+		case r == emojiPresentationSelector && len(digits) > 0:
+			digits[len(digits)-1] = '1'
+		case !isVariationSelector(r):
+			digits = append(digits, '0')
+		}
+	}
+
+	return string(digits)
+}
+
+// withVariationSelectors adds U+FE0F after the code points of the base whose digit is 1. It reports false
+// if the digits do not match the code points of the base.
+func withVariationSelectors(base, digits string) (string, bool) {
+	runes := []rune(base)
+	if len(digits) != len(runes) {
+		return "", false
+	}
+
+	var buf strings.Builder
+	for i, r := range runes {
+		buf.WriteRune(r)
+		switch digits[i] {
+		case '0':
+		case '1':
+			buf.WriteRune(emojiPresentationSelector)
+		default:
+			return "", false
+		}
+	}
+
+	return buf.String(), true
+}
+
+func (t *slugTable) get() *slugTable {
+	t.once.Do(t.build)
+	return t
+}
+
+func (t *slugTable) build() {
+	t.byCharacter = make(map[string]string, len(emojiMap))
+	t.bySlug = make(map[string]string)
+
+	for character, em := range emojiMap {
+		if preferred, ok := t.bySlug[em.Slug]; !ok || preferSpelling(emojiMap, character, preferred) {
+			t.bySlug[em.Slug] = character
+		}
+	}
+
+	for character, em := range emojiMap {
+		token := em.Slug
+		if character != t.bySlug[em.Slug] {
+			token += slugVariantSep + variationSelectorDigits(character)
+		}
+
+		t.byCharacter[character] = token
+	}
+}
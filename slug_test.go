@@ -0,0 +1,106 @@
+package gomoji_test
+
+import (
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestReplaceEmojisWithDelimitedSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "string without emoji",
+			inputStr: "string without emoji",
+			want:     "string without emoji",
+		},
+		{
+			name:     "replace rare emojis",
+			inputStr: "🧖 hello 🦋world",
+			want:     ":person-in-steamy-room: hello :butterfly:world",
+		},
+		{
+			name:     "fully-qualified keycap is preferred",
+			inputStr: "#️⃣ and #⃣",
+			want:     ":keycap-#: and :keycap-#~00:",
+		},
+		{
+			name:     "variation selector after a single emoji rune is kept",
+			inputStr: "🆕️ pants",
+			want:     ":new-button:️ pants",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.ReplaceEmojisWithDelimitedSlug(tt.inputStr); got != tt.want {
+				t.Errorf("ReplaceEmojisWithDelimitedSlug() = \"%v\", want \"%v\"", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceSlugsWithEmojis(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     string
+	}{
+		{
+			name:     "plain slugs are left untouched",
+			inputStr: "hello butterfly world",
+			want:     "hello butterfly world",
+		},
+		{
+			name:     "delimited slugs",
+			inputStr: ":person-in-steamy-room: hello :butterfly:world",
+			want:     "🧖 hello 🦋world",
+		},
+		{
+			name:     "keycap spellings",
+			inputStr: ":keycap-#: and :keycap-#~00:",
+			want:     "#️⃣ and #⃣",
+		},
+		{
+			name:     "variation selectors are spelled out",
+			inputStr: ":rainbow-flag~100: :red-heart~0: :eye-in-speech-bubble~001:",
+			want:     "🏳️‍🌈 ❤ 👁‍🗨️",
+		},
+		{
+			name:     "malformed variation selectors remain",
+			inputStr: ":red-heart~2: :red-heart~00:",
+			want:     ":red-heart~2: :red-heart~00:",
+		},
+		{
+			name:     "unknown slugs and colons remain",
+			inputStr: "at 10:30 :not-an-emoji: :butterfly:",
+			want:     "at 10:30 :not-an-emoji: 🦋",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.ReplaceSlugsWithEmojis(tt.inputStr); got != tt.want {
+				t.Errorf("ReplaceSlugsWithEmojis() = \"%v\", want \"%v\"", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugEncodingRoundTrip(t *testing.T) {
+	encodings := []gomoji.SlugEncoding{
+		gomoji.DefaultSlugEncoding,
+		{Open: "{{", Close: "}}"},
+	}
+
+	for _, enc := range encodings {
+		for _, em := range gomoji.AllEmojis() {
+			for _, s := range []string{em.Character, "a " + em.Character + em.Character + " b:"} {
+				if got := enc.Decode(enc.Encode(s)); got != s {
+					t.Errorf("Decode(Encode(%q)) = %q with %+v", s, got, enc)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package gomoji
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// presentationTable holds the code points that render as emoji by default, i.e. have the Unicode
+// Emoji_Presentation property. It is derived from the emojis of a Matcher: the first code point of a
+// fully-qualified emoji that is neither followed by U+FE0F nor by a skin tone modifier has emoji presentation.
+type presentationTable struct {
+	once  sync.Once
+	runes map[rune]bool
+}
+
+// WithStrict makes the Matcher accept only characters that render as emoji by default, or that are explicitly
+// followed by U+FE0F or a skin tone modifier. Symbols that render as text by default, such as ©, ®, ™ and ‼,
+// are ignored unless followed by U+FE0F, and characters followed by the text presentation selector U+FE0E are
+// always ignored. Custom emojis are subject to it too.
+func WithStrict() Option {
+	return func(o *matcherOptions) {
+		o.strict = true
+	}
+}
+
+// presents reports whether the spelling at the beginning of s renders as emoji under the presentation rules
+// of the Matcher. Only the first two code points of s are taken into account.
+func (m *Matcher) presents(s string) bool {
+	if !m.strict {
+		return true
+	}
+
+	first, size := utf8.DecodeRuneInString(s)
+	second, _ := utf8.DecodeRuneInString(s[size:])
+	switch {
+	case second == textPresentationSelector:
+		return false
+	case second == emojiPresentationSelector || isSkinToneModifier(second):
+		return true
+	default:
+		return m.presentations.get(m.emojis).runes[first]
+	}
+}
+
+func (t *presentationTable) get(emojis map[string]Emoji) *presentationTable {
+	t.once.Do(func() {
+		t.build(emojis)
+	})
+	return t
+}
+
+func (t *presentationTable) build(emojis map[string]Emoji) {
+	t.runes = make(map[rune]bool)
+	for _, em := range emojis {
+		if em.Status != StatusFullyQualified && em.Status != StatusComponent {
+			continue
+		}
+
+		first, size := utf8.DecodeRuneInString(em.Character)
+		second, _ := utf8.DecodeRuneInString(em.Character[size:])
+		if second != emojiPresentationSelector && !isSkinToneModifier(second) {
+			t.runes[first] = true
+		}
+	}
+}
+
+// isSkinToneModifier reports whether r is one of the Fitzpatrick modifiers U+1F3FB..U+1F3FF.
+func isSkinToneModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
@@ -0,0 +1,121 @@
+package gomoji_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestStrictContains(t *testing.T) {
+	m := gomoji.New(gomoji.WithStrict())
+
+	tests := []struct {
+		name     string
+		inputStr string
+		want     bool
+	}{
+		{
+			name:     "trade mark sign",
+			inputStr: "Acme™",
+			want:     false,
+		},
+		{
+			name:     "text presentation symbols",
+			inputStr: "© ® ‼ ☺ ❤ 1 #",
+			want:     false,
+		},
+		{
+			name:     "trade mark sign with emoji presentation selector",
+			inputStr: "Acme™️",
+			want:     true,
+		},
+		{
+			name:     "emoji presentation",
+			inputStr: "hello 🦋",
+			want:     true,
+		},
+		{
+			name:     "emoji with text presentation selector",
+			inputStr: "hello ⌚︎",
+			want:     false,
+		},
+		{
+			name:     "keycap with emoji presentation selector",
+			inputStr: "press #️⃣",
+			want:     true,
+		},
+		{
+			name:     "text presentation symbol with skin tone",
+			inputStr: "☝🏽",
+			want:     true,
+		},
+		{
+			name:     "flag",
+			inputStr: "🇺🇸",
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Contains(tt.inputStr); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictFunctions(t *testing.T) {
+	m := gomoji.New(gomoji.WithStrict())
+	s := "Acme™ ©️ ❤ ❤️ 🦋"
+
+	if got, want := m.Remove(s), "Acme™  ❤  "; got != want {
+		t.Errorf("Remove() = %q, want %q", got, want)
+	}
+	if got, want := m.ReplaceWithSlug(s), "Acme™ copyright ❤ red-heart butterfly"; got != want {
+		t.Errorf("ReplaceWithSlug() = %q, want %q", got, want)
+	}
+
+	var got []string
+	for _, em := range m.CollectAll(s) {
+		got = append(got, em.Slug)
+	}
+	if want := []string{"copyright", "red-heart", "butterfly"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CollectAll() = %v, want %v", got, want)
+	}
+
+	if got := len(m.FindAll(s)); got != 3 {
+		t.Errorf("len(FindAll()) = %d, want 3", got)
+	}
+
+	if !gomoji.ContainsEmoji("Acme™") {
+		t.Error("ContainsEmoji() = false, the default matcher must stay lenient")
+	}
+}
+
+func TestStrictDataset(t *testing.T) {
+	const sample = `# group: Symbols
+# subgroup: other-symbol
+1FAF9                                                  ; fully-qualified     # 🫹 E18.0 sample emoji
+2122                                                   ; fully-qualified     # ™ E0.6 trade mark
+`
+	d, err := gomoji.LoadEmojiTest(strings.NewReader(sample))
+	if err != nil {
+		t.Fatalf("LoadEmojiTest() error = %v", err)
+	}
+
+	// The presentation follows the dataset rather than the bundled list, which has neither 🫹 nor a bare ™.
+	m := gomoji.New(gomoji.WithDataset(d), gomoji.WithStrict())
+	if !m.Contains("x \U0001FAF9") {
+		t.Error("Contains() = false, want true for an emoji of the dataset")
+	}
+	if !m.Contains("Acme™") {
+		t.Error("Contains() = false, want true for a symbol with emoji presentation in the dataset")
+	}
+
+	m = gomoji.New(gomoji.WithDataset(d), gomoji.WithoutTextPresentation())
+	if got := len(m.AllEmojis()); got != 2 {
+		t.Errorf("len(AllEmojis()) = %d, want 2", got)
+	}
+}
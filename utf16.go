@@ -0,0 +1,105 @@
+package gomoji
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// UTF16Match is a Match that additionally carries its position in UTF-16 code units,
+// the unit JavaScript strings and the Telegram Bot API use for text entities.
+type UTF16Match struct {
+	Match
+	// Offset is the number of UTF-16 code units preceding the match.
+	Offset int
+	// Length is the length of the matched substring in UTF-16 code units.
+	Length int
+}
+
+// MatchesUTF16 finds all emojis in given string like Matches and reports their offset and length
+// in UTF-16 code units. If there are no emojis it returns a nil-slice.
+func MatchesUTF16(s string) []UTF16Match {
+	return defaultMatcher.MatchesUTF16(s)
+}
+
+// MatchesUTF16 finds all emojis in given string like Matches and reports their offset and length
+// in UTF-16 code units. See the package-level MatchesUTF16.
+func (m *Matcher) MatchesUTF16(s string) []UTF16Match {
+	matches := m.Matches(s)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	result := make([]UTF16Match, 0, len(matches))
+
+	var pos, offset int
+	for _, match := range matches {
+		offset += utf16Len(s[pos:match.Start])
+		length := utf16Len(match.Str)
+
+		result = append(result, UTF16Match{
+			Match:  match,
+			Offset: offset,
+			Length: length,
+		})
+
+		offset += length
+		pos = match.End
+	}
+
+	return result
+}
+
+// UTF16Offset converts the byteOffset in s to an offset in UTF-16 code units.
+// It returns the gomoji.ErrInvalidOffset error if byteOffset is out of range or does not fall on a rune boundary.
+func UTF16Offset(s string, byteOffset int) (int, error) {
+	if byteOffset < 0 || byteOffset > len(s) {
+		return 0, ErrInvalidOffset
+	}
+	if byteOffset < len(s) && !utf8.RuneStart(s[byteOffset]) {
+		return 0, ErrInvalidOffset
+	}
+
+	return utf16Len(s[:byteOffset]), nil
+}
+
+// ByteOffset converts the utf16Offset in s to a byte offset.
+// It returns the gomoji.ErrInvalidOffset error if utf16Offset is out of range or splits a surrogate pair.
+func ByteOffset(s string, utf16Offset int) (int, error) {
+	if utf16Offset < 0 {
+		return 0, ErrInvalidOffset
+	}
+
+	var units int
+	for i, r := range s {
+		if units == utf16Offset {
+			return i, nil
+		}
+		if units > utf16Offset {
+			return 0, ErrInvalidOffset
+		}
+		units += utf16RuneLen(r)
+	}
+
+	if units == utf16Offset {
+		return len(s), nil
+	}
+
+	return 0, ErrInvalidOffset
+}
+
+func utf16Len(s string) int {
+	var n int
+	for _, r := range s {
+		n += utf16RuneLen(r)
+	}
+
+	return n
+}
+
+func utf16RuneLen(r rune) int {
+	if r1, _ := utf16.EncodeRune(r); r1 != utf8.RuneError {
+		return 2
+	}
+
+	return 1
+}
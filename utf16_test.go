@@ -0,0 +1,125 @@
+package gomoji_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestMatchesUTF16(t *testing.T) {
+	type entity struct {
+		slug           string
+		offset, length int
+	}
+
+	tests := []struct {
+		name     string
+		inputStr string
+		want     []entity
+	}{
+		{
+			name:     "string without emoji",
+			inputStr: "hello world",
+			want:     nil,
+		},
+		{
+			name:     "BMP and astral emojis",
+			inputStr: "I ❤️ 🦋",
+			want: []entity{
+				{slug: "red-heart", offset: 2, length: 2},
+				{slug: "butterfly", offset: 5, length: 2},
+			},
+		},
+		{
+			name:     "zero width joiner sequence",
+			inputStr: "é 👨‍👩‍👧‍👦 🦋",
+			want: []entity{
+				{slug: "family-man,-woman,-girl,-boy", offset: 2, length: 11},
+				{slug: "butterfly", offset: 14, length: 2},
+			},
+		},
+		{
+			name:     "tag sequence flag",
+			inputStr: "go 🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F!",
+			want: []entity{
+				{slug: "flag-england", offset: 3, length: 14},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gomoji.MatchesUTF16(tt.inputStr)
+			if len(got) != len(tt.want) {
+				t.Fatalf("MatchesUTF16() returned %d matches, want %d: %v", len(got), len(tt.want), got)
+			}
+
+			for i, m := range got {
+				gotEntity := entity{slug: m.Emoji.Slug, offset: m.Offset, length: m.Length}
+				if gotEntity != tt.want[i] {
+					t.Errorf("MatchesUTF16()[%d] = %+v, want %+v", i, gotEntity, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestUTF16Offset(t *testing.T) {
+	s := "a🦋b"
+
+	tests := []struct {
+		name       string
+		byteOffset int
+		want       int
+		wantErr    error
+	}{
+		{name: "start of string", byteOffset: 0, want: 0},
+		{name: "before surrogate pair", byteOffset: 1, want: 1},
+		{name: "after surrogate pair", byteOffset: 5, want: 3},
+		{name: "end of string", byteOffset: 6, want: 4},
+		{name: "inside a rune", byteOffset: 2, wantErr: gomoji.ErrInvalidOffset},
+		{name: "out of range", byteOffset: 7, wantErr: gomoji.ErrInvalidOffset},
+		{name: "negative", byteOffset: -1, wantErr: gomoji.ErrInvalidOffset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.UTF16Offset(s, tt.byteOffset)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UTF16Offset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("UTF16Offset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByteOffset(t *testing.T) {
+	s := "a🦋b"
+
+	tests := []struct {
+		name        string
+		utf16Offset int
+		want        int
+		wantErr     error
+	}{
+		{name: "start of string", utf16Offset: 0, want: 0},
+		{name: "before surrogate pair", utf16Offset: 1, want: 1},
+		{name: "after surrogate pair", utf16Offset: 3, want: 5},
+		{name: "end of string", utf16Offset: 4, want: 6},
+		{name: "inside surrogate pair", utf16Offset: 2, wantErr: gomoji.ErrInvalidOffset},
+		{name: "out of range", utf16Offset: 5, wantErr: gomoji.ErrInvalidOffset},
+		{name: "negative", utf16Offset: -1, wantErr: gomoji.ErrInvalidOffset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gomoji.ByteOffset(s, tt.utf16Offset)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ByteOffset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ByteOffset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
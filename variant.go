@@ -0,0 +1,282 @@
+package gomoji
+
+import (
+	"strings"
+	"sync"
+)
+
+const (
+	zeroWidthJoiner = '\u200D'
+	femaleSign      = '\u2640'
+	maleSign        = '\u2642'
+	rightArrow      = '\u27A1'
+)
+
+// Gender is the gender of a person emoji, e.g. woman running for 🏃‍♀️.
+type Gender uint8
+
+// Genders. GenderUnspecified stands for the gender-neutral person, e.g. person running for 🏃.
+const (
+	GenderUnspecified Gender = iota
+	GenderFemale
+	GenderMale
+)
+
+// Direction is the direction a person emoji is facing.
+type Direction uint8
+
+// Directions. DirectionDefault stands for the direction the emoji faces without a direction sequence,
+// which is left for most of them.
+const (
+	DirectionDefault Direction = iota
+	DirectionRight
+)
+
+// HairStyle is one of the hair style components U+1F9B0..U+1F9B3.
+type HairStyle uint8
+
+// Hair styles. HairStyleDefault stands for the emoji without a hair style component.
+const (
+	HairStyleDefault HairStyle = iota
+	HairStyleRed
+	HairStyleCurly
+	HairStyleBald
+	HairStyleWhite
+)
+
+const firstHairStyleComponent = '\U0001F9B0'
+
+// Traits are the variable traits of a person emoji. The emojis that differ only in traits are variants of each other.
+type Traits struct {
+	Gender    Gender
+	SkinTones []SkinTone
+	HairStyle HairStyle
+	Direction Direction
+}
+
+// genderedPersons maps the gender-neutral person emojis to their female and male counterparts.
+var genderedPersons = map[rune][2]rune{
+	'\U0001F9D1': {'\U0001F469', '\U0001F468'}, // person: woman, man
+	'\U0001F9D2': {'\U0001F467', '\U0001F466'}, // child: girl, boy
+	'\U0001F9D3': {'\U0001F475', '\U0001F474'}, // older person: old woman, old man
+	'\U0001FAC5': {'\U0001F478', '\U0001F934'}, // person with crown: princess, prince
+}
+
+var neutralPersons = func() map[rune]personGender {
+	persons := make(map[rune]personGender)
+	for neutral, gendered := range genderedPersons {
+		persons[neutral] = personGender{neutral: neutral, gender: GenderUnspecified}
+		persons[gendered[0]] = personGender{neutral: neutral, gender: GenderFemale}
+		persons[gendered[1]] = personGender{neutral: neutral, gender: GenderMale}
+	}
+
+	return persons
+}()
+
+type personGender struct {
+	neutral rune
+	gender  Gender
+}
+
+var variants variantTable
+
+// variantTable groups the canonical spellings of the emojis by their spelling without traits.
+type variantTable struct {
+	once     sync.Once
+	byFamily map[string][]string
+}
+
+// TraitsOf returns the traits of the emoji.
+func TraitsOf(e Emoji) Traits {
+	_, traits := familyOf(e)
+	return traits
+}
+
+// Variants returns all variants of the emoji along the gender, skin tone, hair style and direction axes,
+// including the emoji itself, in canonical spelling and ordered like AllEmojis. For example, the variants
+// of 🏃 include 🏃‍♀️, 🏃🏽‍♂️ and 🏃‍♀️‍➡️. If the emoji has no variants, it returns just the emoji.
+func Variants(e Emoji) []Emoji {
+	family, _ := familyOf(e)
+
+	characters := variants.get().byFamily[family]
+	if len(characters) == 0 {
+		return []Emoji{e}
+	}
+
+	emojis := make([]Emoji, 0, len(characters))
+	for _, character := range characters {
+		emojis = append(emojis, emojiMap[character])
+	}
+
+	return emojis
+}
+
+// BaseVariant returns the variant of the emoji without traits, e.g. 🏃 for 🏃🏽‍♀️‍➡️ and 🧑 for 👩‍🦰.
+// The emoji is returned as is if there is no such variant.
+func BaseVariant(e Emoji) Emoji {
+	em, err := withTraits(e, func(t *Traits) {
+		*t = Traits{}
+	})
+	if err != nil {
+		return e
+	}
+
+	return em
+}
+
+// WithGender returns the variant of the emoji with the given gender and the other traits kept.
+// If the emoji has no such variant, it returns the gomoji.ErrVariantNotFound error.
+func WithGender(e Emoji, g Gender) (Emoji, error) {
+	return withTraits(e, func(t *Traits) {
+		t.Gender = g
+	})
+}
+
+// WithDirection returns the variant of the emoji facing the given direction with the other traits kept.
+// If the emoji has no such variant, it returns the gomoji.ErrVariantNotFound error.
+func WithDirection(e Emoji, d Direction) (Emoji, error) {
+	return withTraits(e, func(t *Traits) {
+		t.Direction = d
+	})
+}
+
+// WithHairStyle returns the variant of the emoji with the given hair style and the other traits kept.
+// If the emoji has no such variant, it returns the gomoji.ErrVariantNotFound error.
+func WithHairStyle(e Emoji, h HairStyle) (Emoji, error) {
+	return withTraits(e, func(t *Traits) {
+		t.HairStyle = h
+	})
+}
+
+// withTraits finds the variant of the emoji whose traits equal the traits of the emoji changed by the change function.
+func withTraits(e Emoji, change func(t *Traits)) (Emoji, error) {
+	family, want := familyOf(e)
+	change(&want)
+
+	for _, character := range variants.get().byFamily[family] {
+		if _, traits := familyOf(emojiMap[character]); traits.equal(want) {
+			return emojiMap[character], nil
+		}
+	}
+
+	return Emoji{}, ErrVariantNotFound
+}
+
+func (t Traits) equal(other Traits) bool {
+	if t.Gender != other.Gender || t.HairStyle != other.HairStyle || t.Direction != other.Direction {
+		return false
+	}
+	if len(t.SkinTones) != len(other.SkinTones) {
+		return false
+	}
+	for i := range t.SkinTones {
+		if t.SkinTones[i] != other.SkinTones[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *variantTable) get() *variantTable {
+	t.once.Do(t.build)
+	return t
+}
+
+func (t *variantTable) build() {
+	t.byFamily = make(map[string][]string)
+	for _, entry := range AllEntries() {
+		if entry.Status == StatusUnknown {
+			continue
+		}
+
+		family, _ := familyOf(entry.Emoji)
+		t.byFamily[family] = append(t.byFamily[family], entry.Character)
+	}
+}
+
+// familyOf returns the spelling of the family of the emoji and its traits. Toned sequences of two people
+// that have no toneless counterpart, such as 🧑🏻‍❤️‍🧑🏿, belong to the family of the emoji WithoutSkinTone returns.
+func familyOf(e Emoji) (string, Traits) {
+	family, traits := splitTraits(e.Character)
+	if len(traits.SkinTones) > 0 {
+		family, _ = splitTraits(WithoutSkinTone(e).Character)
+	}
+
+	return family, traits
+}
+
+// splitTraits splits the spelling of an emoji into the spelling of its family without traits and the traits.
+// Variation selectors and skin tone modifiers are removed, as are the ZWJ components for gender signs,
+// hair styles and direction. Men and women are replaced with the gender-neutral person unless the
+// sequence has people of different kinds, such as families.
+func splitTraits(s string) (string, Traits) {
+	var traits Traits
+	for _, r := range s {
+		if isSkinToneModifier(r) {
+			traits.SkinTones = append(traits.SkinTones, SkinTone(r-firstSkinToneModifier+1))
+		}
+	}
+
+	components := strings.Split(strings.Map(dropTraitModifier, s), string(zeroWidthJoiner))
+	kept := []string{components[0]}
+	for _, c := range components[1:] {
+		switch r := []rune(c); {
+		case len(r) != 1:
+			kept = append(kept, c)
+		case r[0] == femaleSign:
+			traits.Gender = GenderFemale
+		case r[0] == maleSign:
+			traits.Gender = GenderMale
+		case r[0] == rightArrow:
+			traits.Direction = DirectionRight
+		case r[0] >= firstHairStyleComponent && r[0] <= firstHairStyleComponent+3:
+			traits.HairStyle = HairStyle(r[0]-firstHairStyleComponent) + HairStyleRed
+		default:
+			kept = append(kept, c)
+		}
+	}
+
+	if gender, ok := neutralizePerson(kept); ok {
+		traits.Gender = gender
+	}
+
+	return strings.Join(kept, string(zeroWidthJoiner)), traits
+}
+
+// neutralizePerson replaces the men or women in the ZWJ components with the gender-neutral person and
+// reports the replaced gender. Sequences of people of different kinds, such as families, are left as is.
+func neutralizePerson(components []string) (Gender, bool) {
+	var persons []int
+	var person personGender
+	for i, c := range components {
+		r := []rune(c)
+		if len(r) != 1 {
+			continue
+		}
+		p, ok := neutralPersons[r[0]]
+		if !ok {
+			continue
+		}
+		if len(persons) > 0 && p != person {
+			return GenderUnspecified, false
+		}
+		persons, person = append(persons, i), p
+	}
+	if len(persons) == 0 {
+		return GenderUnspecified, false
+	}
+
+	for _, i := range persons {
+		components[i] = string(person.neutral)
+	}
+
+	return person.gender, person.gender != GenderUnspecified
+}
+
+func dropTraitModifier(r rune) rune {
+	if isVariationSelector(r) || isSkinToneModifier(r) {
+		return -1
+	}
+	return r
+}
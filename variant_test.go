@@ -0,0 +1,269 @@
+package gomoji_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestTraitsOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		want  gomoji.Traits
+	}{
+		{
+			name:  "no traits",
+			emoji: "🏃",
+			want:  gomoji.Traits{},
+		},
+		{
+			name:  "all traits",
+			emoji: "🏃🏽‍♀️‍➡️",
+			want: gomoji.Traits{
+				Gender:    gomoji.GenderFemale,
+				SkinTones: []gomoji.SkinTone{gomoji.SkinToneMedium},
+				Direction: gomoji.DirectionRight,
+			},
+		},
+		{
+			name:  "gendered person with hair style",
+			emoji: "👨🏿‍🦱",
+			want: gomoji.Traits{
+				Gender:    gomoji.GenderMale,
+				SkinTones: []gomoji.SkinTone{gomoji.SkinToneDark},
+				HairStyle: gomoji.HairStyleCurly,
+			},
+		},
+		{
+			name:  "gendered person with profession",
+			emoji: "👩‍⚕️",
+			want:  gomoji.Traits{Gender: gomoji.GenderFemale},
+		},
+		{
+			name:  "family keeps its people",
+			emoji: "👨‍👩‍👦",
+			want:  gomoji.Traits{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+			if got := gomoji.TraitsOf(em); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TraitsOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		emoji    string
+		contains []string
+		base     string
+	}{
+		{
+			name:     "person running",
+			emoji:    "🏃🏽‍♀️‍➡️",
+			contains: []string{"🏃", "🏃‍♀️", "🏃‍♂️", "🏃‍➡️", "🏃🏿‍♂️‍➡️"},
+			base:     "🏃",
+		},
+		{
+			name:     "person with hair style",
+			emoji:    "👩‍🦰",
+			contains: []string{"🧑", "👨", "👩", "🧑‍🦰", "👨🏻‍🦳"},
+			base:     "🧑",
+		},
+		{
+			name:     "health worker",
+			emoji:    "👨‍⚕️",
+			contains: []string{"🧑‍⚕️", "👩‍⚕️", "👩🏾‍⚕️"},
+			base:     "🧑‍⚕️",
+		},
+		{
+			name:     "couple with mixed skin tones",
+			emoji:    "🧑🏻‍❤️‍🧑🏿",
+			contains: []string{"💑", "💑🏻", "🧑🏿‍❤️‍🧑🏻"},
+			base:     "💑",
+		},
+		{
+			name:     "no variants",
+			emoji:    "🦋",
+			contains: []string{"🦋"},
+			base:     "🦋",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+
+			got := make(map[string]bool)
+			for _, v := range gomoji.Variants(em) {
+				got[v.Character] = true
+			}
+			for _, want := range tt.contains {
+				if !got[want] {
+					t.Errorf("Variants() does not contain %q", want)
+				}
+			}
+
+			if got := gomoji.BaseVariant(em); got.Character != tt.base {
+				t.Errorf("BaseVariant() = %q, want %q", got.Character, tt.base)
+			}
+		})
+	}
+}
+
+func TestWithGender(t *testing.T) {
+	tests := []struct {
+		name    string
+		emoji   string
+		gender  gomoji.Gender
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "sign sequence",
+			emoji:  "🏃🏽‍♀️‍➡️",
+			gender: gomoji.GenderMale,
+			want:   "🏃🏽‍♂️‍➡️",
+		},
+		{
+			name:   "to gender-neutral",
+			emoji:  "🏃‍♀️",
+			gender: gomoji.GenderUnspecified,
+			want:   "🏃",
+		},
+		{
+			name:   "person sequence",
+			emoji:  "🧑🏻‍🦰",
+			gender: gomoji.GenderFemale,
+			want:   "👩🏻‍🦰",
+		},
+		{
+			name:   "person",
+			emoji:  "👨",
+			gender: gomoji.GenderFemale,
+			want:   "👩",
+		},
+		{
+			name:    "no such variant",
+			emoji:   "🦋",
+			gender:  gomoji.GenderFemale,
+			wantErr: gomoji.ErrVariantNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+
+			got, err := gomoji.WithGender(em, tt.gender)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WithGender() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Character != tt.want {
+				t.Errorf("WithGender() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithDirection(t *testing.T) {
+	tests := []struct {
+		name      string
+		emoji     string
+		direction gomoji.Direction
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "facing right",
+			emoji:     "🚶🏽‍♀️",
+			direction: gomoji.DirectionRight,
+			want:      "🚶🏽‍♀️‍➡️",
+		},
+		{
+			name:      "facing default",
+			emoji:     "🧑‍🦯‍➡️",
+			direction: gomoji.DirectionDefault,
+			want:      "🧑‍🦯",
+		},
+		{
+			name:      "no such variant",
+			emoji:     "👮",
+			direction: gomoji.DirectionRight,
+			wantErr:   gomoji.ErrVariantNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em, err := gomoji.GetInfo(tt.emoji)
+			if err != nil {
+				t.Fatalf("GetInfo() error = %v", err)
+			}
+
+			got, err := gomoji.WithDirection(em, tt.direction)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WithDirection() error = %v, want %v", err, tt.wantErr)
+			}
+			if got.Character != tt.want {
+				t.Errorf("WithDirection() = %q, want %q", got.Character, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithHairStyle(t *testing.T) {
+	em, err := gomoji.GetInfo("👩🏾")
+	if err != nil {
+		t.Fatalf("GetInfo() error = %v", err)
+	}
+
+	got, err := gomoji.WithHairStyle(em, gomoji.HairStyleWhite)
+	if err != nil {
+		t.Fatalf("WithHairStyle() error = %v", err)
+	}
+	if want := "👩🏾‍🦳"; got.Character != want {
+		t.Errorf("WithHairStyle() = %q, want %q", got.Character, want)
+	}
+}
+
+func TestVariantsRoundTrip(t *testing.T) {
+	for _, entry := range gomoji.AllEntries() {
+		if entry.Status == gomoji.StatusUnknown {
+			continue
+		}
+
+		traits := gomoji.TraitsOf(entry.Emoji)
+		base := gomoji.BaseVariant(entry.Emoji)
+		for _, got := range []func() (gomoji.Emoji, error){
+			func() (gomoji.Emoji, error) { return gomoji.WithGender(entry.Emoji, traits.Gender) },
+			func() (gomoji.Emoji, error) { return gomoji.WithDirection(entry.Emoji, traits.Direction) },
+			func() (gomoji.Emoji, error) { return gomoji.WithHairStyle(entry.Emoji, traits.HairStyle) },
+		} {
+			if em, err := got(); err != nil || em.Character != entry.Character {
+				t.Errorf("%q with its own traits = %q, %v", entry.Character, em.Character, err)
+			}
+		}
+
+		found := false
+		for _, v := range gomoji.Variants(base) {
+			found = found || v.Character == entry.Character
+		}
+		if !found {
+			t.Errorf("Variants(%q) does not contain %q", base.Character, entry.Character)
+		}
+	}
+}
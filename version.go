@@ -0,0 +1,78 @@
+package gomoji
+
+// VersionRange is an inclusive range of emoji versions. A zero Max means there is no upper bound.
+type VersionRange struct {
+	Min Version
+	Max Version
+}
+
+// Compare returns -1 if v is lower than other, 1 if v is higher than other, and 0 if they are equal.
+func (v Version) Compare(other Version) int {
+	if v.Major != other.Major {
+		return compareInts(v.Major, other.Major)
+	}
+
+	return compareInts(v.Minor, other.Minor)
+}
+
+// Contains reports whether the version v is within the range.
+func (r VersionRange) Contains(v Version) bool {
+	if v.Compare(r.Min) < 0 {
+		return false
+	}
+
+	return r.Max == (Version{}) || v.Compare(r.Max) <= 0
+}
+
+// MinVersionRequired returns the highest emoji version among the emojis in the s string,
+// i.e. the emoji version a device must support to render all of them. If there are no emojis it returns a zero Version.
+func MinVersionRequired(s string) Version {
+	return defaultMatcher.MinVersionRequired(s)
+}
+
+// MinVersionRequired returns the highest emoji version among the emojis in the s string.
+// See the package-level MinVersionRequired.
+func (m *Matcher) MinVersionRequired(s string) Version {
+	var required Version
+	for _, match := range m.Matches(s) {
+		if match.Emoji.Version.Compare(required) > 0 {
+			required = match.Emoji.Version
+		}
+	}
+
+	return required
+}
+
+// ReplaceEmojisInRange replaces the emojis from the s string whose version is within the range r with the result
+// of the replacerFn function and returns a new string. Other emojis are kept. If the replacer is nil, the emojis
+// within the range are removed. To replace emojis newer than Emoji 13.0, use VersionRange{Min: Version{Major: 13, Minor: 1}}.
+// Unlike ReplaceEmojisWithFunc, it keeps the rest of the string as is, so the kept emojis retain their variation selectors.
+func ReplaceEmojisInRange(s string, r VersionRange, replacer replacerFn) string {
+	return defaultMatcher.ReplaceInRange(s, r, replacer)
+}
+
+// ReplaceInRange replaces the emojis from the s string whose version is within the range r with the result
+// of the replacerFn function and returns a new string. See the package-level ReplaceEmojisInRange.
+func (m *Matcher) ReplaceInRange(s string, r VersionRange, replacer replacerFn) string {
+	return m.replaceMatches(s, func(match Match) (string, bool) {
+		if !r.Contains(match.Emoji.Version) {
+			return "", false
+		}
+		if replacer == nil {
+			return "", true
+		}
+
+		return replacer(match.Emoji), true
+	})
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
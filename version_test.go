@@ -0,0 +1,137 @@
+package gomoji_test
+
+import (
+	"testing"
+
+	"github.com/forPelevin/gomoji"
+)
+
+func TestVersionRangeContains(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       gomoji.VersionRange
+		version gomoji.Version
+		want    bool
+	}{
+		{
+			name:    "zero range contains everything",
+			version: gomoji.Version{Major: 16},
+			want:    true,
+		},
+		{
+			name:    "lower bound is inclusive",
+			r:       gomoji.VersionRange{Min: gomoji.Version{Major: 13, Minor: 1}},
+			version: gomoji.Version{Major: 13, Minor: 1},
+			want:    true,
+		},
+		{
+			name:    "below lower bound",
+			r:       gomoji.VersionRange{Min: gomoji.Version{Major: 13, Minor: 1}},
+			version: gomoji.Version{Major: 13},
+			want:    false,
+		},
+		{
+			name:    "upper bound is inclusive",
+			r:       gomoji.VersionRange{Max: gomoji.Version{Major: 13}},
+			version: gomoji.Version{Major: 13},
+			want:    true,
+		},
+		{
+			name:    "above upper bound",
+			r:       gomoji.VersionRange{Max: gomoji.Version{Major: 13}},
+			version: gomoji.Version{Major: 14},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Contains(tt.version); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinVersionRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputStr string
+		want     gomoji.Version
+	}{
+		{
+			name:     "string without emoji",
+			inputStr: "hello world",
+			want:     gomoji.Version{},
+		},
+		{
+			name:     "single emoji",
+			inputStr: "hello 🦋",
+			want:     gomoji.Version{Major: 3},
+		},
+		{
+			name:     "the newest emoji wins",
+			inputStr: "❤️ 🐦‍🔥 🫩 🦋",
+			want:     gomoji.Version{Major: 16},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.MinVersionRequired(tt.inputStr); got != tt.want {
+				t.Errorf("MinVersionRequired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceEmojisInRange(t *testing.T) {
+	newerThan13 := gomoji.VersionRange{Min: gomoji.Version{Major: 13, Minor: 1}}
+
+	tests := []struct {
+		name     string
+		inputStr string
+		r        gomoji.VersionRange
+		replacer func(e gomoji.Emoji) string
+		want     string
+	}{
+		{
+			name:     "emojis newer than 13.0 are replaced",
+			inputStr: "🦋 🐦‍🔥 🫩",
+			r:        newerThan13,
+			replacer: func(_ gomoji.Emoji) string {
+				return "?"
+			},
+			want: "🦋 ? ?",
+		},
+		{
+			name:     "replacer is nil, so emojis within the range are removed",
+			inputStr: "🦋 🐦‍🔥",
+			r:        newerThan13,
+			want:     "🦋 ",
+		},
+		{
+			name:     "emojis below the range are kept with variation selectors",
+			inputStr: "I ❤️ 🫩",
+			r:        gomoji.VersionRange{Min: gomoji.Version{Major: 16}},
+			replacer: func(e gomoji.Emoji) string {
+				return e.Slug
+			},
+			want: "I ❤️ face-with-bags-under-eyes",
+		},
+		{
+			name:     "zwj sequences below the range are kept intact",
+			inputStr: "❤️ 🏳️‍🌈 ☺️ 🫠",
+			r:        newerThan13,
+			replacer: func(_ gomoji.Emoji) string {
+				return "?"
+			},
+			want: "❤️ 🏳️‍🌈 ☺️ ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gomoji.ReplaceEmojisInRange(tt.inputStr, tt.r, tt.replacer); got != tt.want {
+				t.Errorf("ReplaceEmojisInRange() = \"%v\", want \"%v\"", got, tt.want)
+			}
+		})
+	}
+}